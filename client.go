@@ -1,15 +1,21 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
 	"github.com/irisnet/irishub-sdk-go/modules/coinswap"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/feegrant"
 	"github.com/irisnet/irishub-sdk-go/modules/gov"
 	"github.com/irisnet/irishub-sdk-go/modules/htlc"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/transfer"
 	"github.com/irisnet/irishub-sdk-go/modules/nft"
 	"github.com/irisnet/irishub-sdk-go/modules/oracle"
 	"github.com/irisnet/irishub-sdk-go/modules/random"
 	"github.com/irisnet/irishub-sdk-go/modules/record"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
 	"github.com/irisnet/irishub-sdk-go/modules/staking"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -30,20 +36,26 @@ type IRISHUBClient struct {
 	logger         log.Logger
 	moduleManager  map[string]types.Module
 	encodingConfig types.EncodingConfig
+	eventRegistry  *types.EventRegistry
 
 	types.BaseClient
-	Key     keys.Client
-	Bank    bank.Client
-	Token   token.Client
-	Staking staking.Client
-	Gov     gov.Client
-	Service service.Client
-	Record  record.Client
-	Random  random.Client
-	NFT     nft.Client
-	Oracle  oracle.Client
-	HTLC    htlc.Client
-	Swap    coinswap.Client
+	Key          keys.Client
+	Bank         bank.Client
+	Token        token.Client
+	Staking      staking.Client
+	Distribution distribution.Client
+	Slashing     slashing.Client
+	Gov          gov.Client
+	Service      service.Client
+	Record       record.Client
+	Random       random.Client
+	NFT          nft.Client
+	Oracle       oracle.Client
+	HTLC         htlc.Client
+	Swap         coinswap.Client
+	Transfer     transfer.Client
+	Authz        authz.Client
+	Feegrant     feegrant.Client
 }
 
 func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
@@ -56,6 +68,8 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 	bankClient := bank.NewClient(baseClient, encodingConfig.Marshaler)
 	tokenClient := token.NewClient(baseClient, encodingConfig.Marshaler)
 	stakingClient := staking.NewClient(baseClient, encodingConfig.Marshaler)
+	distributionClient := distribution.NewClient(baseClient, encodingConfig.Marshaler)
+	slashingClient := slashing.NewClient(baseClient, encodingConfig.Marshaler, stakingClient.QueryValidator)
 	govClient := gov.NewClient(baseClient, encodingConfig.Marshaler)
 
 	serviceClient := service.NewClient(baseClient, encodingConfig.Marshaler)
@@ -65,16 +79,22 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 	oracleClient := oracle.NewClient(baseClient, encodingConfig.Marshaler)
 	htlcClient := htlc.NewClient(baseClient, encodingConfig.Marshaler)
 	swapClient := coinswap.NewClient(baseClient, encodingConfig.Marshaler, bankClient.TotalSupply)
+	transferClient := transfer.NewClient(baseClient, encodingConfig.Marshaler)
+	authzClient := authz.NewClient(baseClient, encodingConfig.Marshaler)
+	feegrantClient := feegrant.NewClient(baseClient, encodingConfig.Marshaler)
 
 	client := &IRISHUBClient{
 		logger:         baseClient.Logger(),
 		BaseClient:     baseClient,
 		moduleManager:  make(map[string]types.Module),
 		encodingConfig: encodingConfig,
+		eventRegistry:  types.NewEventRegistry(),
 		Key:            keysClient,
 		Bank:           bankClient,
 		Token:          tokenClient,
 		Staking:        stakingClient,
+		Distribution:   distributionClient,
+		Slashing:       slashingClient,
 		Gov:            govClient,
 		Service:        serviceClient,
 		Record:         recordClient,
@@ -83,12 +103,17 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 		Oracle:         oracleClient,
 		HTLC:           htlcClient,
 		Swap:           swapClient,
+		Transfer:       transferClient,
+		Authz:          authzClient,
+		Feegrant:       feegrantClient,
 	}
 
 	client.RegisterModule(
 		bankClient,
 		tokenClient,
 		stakingClient,
+		distributionClient,
+		slashingClient,
 		govClient,
 		serviceClient,
 		recordClient,
@@ -97,10 +122,66 @@ func NewIRISHUBClient(cfg types.ClientConfig) IRISHUBClient {
 		oracleClient,
 		htlcClient,
 		swapClient,
+		transferClient,
+		authzClient,
+		feegrantClient,
 	)
 	return *client
 }
 
+// WithContext returns a copy of the client whose module clients are bound to ctx, the deadline
+// and the cancellation of ctx are propagated to the gRPC and Tendermint RPC calls.
+func (client *IRISHUBClient) WithContext(ctx context.Context) IRISHUBClient {
+	c := *client
+	c.BaseClient = client.BaseClient.WithContext(ctx)
+	c.Bank = client.Bank.WithContext(ctx)
+	c.Token = client.Token.WithContext(ctx)
+	c.Staking = client.Staking.WithContext(ctx)
+	c.Distribution = client.Distribution.WithContext(ctx)
+	c.Slashing = slashing.NewClient(c.BaseClient, c.encodingConfig.Marshaler, c.Staking.QueryValidator)
+	c.Gov = client.Gov.WithContext(ctx)
+	c.Service = client.Service.WithContext(ctx)
+	c.Record = client.Record.WithContext(ctx)
+	c.Random = client.Random.WithContext(ctx)
+	c.NFT = client.NFT.WithContext(ctx)
+	c.Oracle = client.Oracle.WithContext(ctx)
+	c.HTLC = client.HTLC.WithContext(ctx)
+	c.Swap = coinswap.NewClient(c.BaseClient, c.encodingConfig.Marshaler, c.Bank.TotalSupply)
+	c.Transfer = client.Transfer.WithContext(ctx)
+	c.Authz = client.Authz.WithContext(ctx)
+	c.Feegrant = client.Feegrant.WithContext(ctx)
+	return c
+}
+
+// CollectMsgs calls the callback with a copy of the client whose module calls collect the msgs
+// they build instead of broadcasting them, e.g. to execute them on behalf of a granter:
+//
+//	msgs, err := client.CollectMsgs(func(c IRISHUBClient) error {
+//		_, err := c.Gov.Vote(gov.VoteRequest{ProposalId: 1, Option: "VOTE_OPTION_YES"}, types.BaseTx{From: granter})
+//		return err
+//	}, granter)
+//	result, err := client.Authz.Exec(msgs, baseTx)
+//
+// The collected calls return types.ErrMsgCollected, which is not an error of the callback. The From
+// of the collected calls may be the bech32 address of one of the granters, whose keys don't need
+// to be in the KeyDAO.
+func (client *IRISHUBClient) CollectMsgs(callback func(c IRISHUBClient) error, granters ...string) ([]types.Msg, error) {
+	signers := make([]types.AccAddress, len(granters))
+	for i, granter := range granters {
+		address, err := types.AccAddressFromBech32(granter)
+		if err != nil {
+			return nil, err
+		}
+		signers[i] = address
+	}
+
+	ctx, collector := types.NewMsgCollectorContext(client.Context(), signers...)
+	if err := callback(client.WithContext(ctx)); err != nil && !types.IsMsgCollected(err) {
+		return nil, err
+	}
+	return collector.Msgs(), nil
+}
+
 func (client *IRISHUBClient) SetLogger(logger log.Logger) {
 	client.BaseClient.SetLogger(logger)
 }
@@ -117,6 +198,19 @@ func (client *IRISHUBClient) EncodingConfig() types.EncodingConfig {
 	return client.encodingConfig
 }
 
+// EventRegistry returns the registry of the typed events of the registered modules, which decodes
+// the events of a tx or a block, for example:
+//
+//	events, err := client.EventRegistry().ParseTxResult(tx.Result)
+//	for _, e := range events {
+//		if delegate, ok := e.(*staking.DelegateEvent); ok {
+//			...
+//		}
+//	}
+func (client *IRISHUBClient) EventRegistry() *types.EventRegistry {
+	return client.eventRegistry
+}
+
 func (client *IRISHUBClient) Manager() types.BaseClient {
 	return client.BaseClient
 }
@@ -130,6 +224,9 @@ func (client *IRISHUBClient) RegisterModule(ms ...types.Module) {
 
 		// m.RegisterCodec(client.encodingConfig.Amino)
 		m.RegisterInterfaceTypes(client.encodingConfig.InterfaceRegistry)
+		if r, ok := m.(types.EventRegistrar); ok {
+			r.RegisterEvents(client.eventRegistry)
+		}
 		client.moduleManager[m.Name()] = m
 	}
 }
@@ -0,0 +1,40 @@
+package signer
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const bufSize = 1024 * 1024
+
+// NewInProcessSigner serves the keys of backend with the reference signer server over an in-memory
+// connection, it speaks the same protocol as an external signer daemon and is meant to stand in
+// for it in the tests. Closing the returned RemoteSigner also stops the server.
+func NewInProcessSigner(backend sdk.Signer, password PasswordFunc) (*RemoteSigner, error) {
+	lis := bufconn.Listen(bufSize)
+
+	s := grpc.NewServer()
+	RegisterSignerServer(s, NewServer(backend, password))
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	remote, err := NewRemoteSigner("bufnet",
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+	)
+	if err != nil {
+		s.Stop()
+		return nil, err
+	}
+
+	remote.closeFn = s.Stop
+	return remote, nil
+}
@@ -0,0 +1,139 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"google.golang.org/grpc"
+
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// DefaultTimeout bounds each call to the signer daemon, unless changed by WithTimeout
+const DefaultTimeout = 10 * time.Second
+
+var _ sdk.ContextSigner = &RemoteSigner{}
+
+// RemoteSigner is a sdk.Signer delegating the signing to an external signer daemon
+// through the Signer gRPC service, the private keys never enter the SDK process
+type RemoteSigner struct {
+	conn     *grpc.ClientConn
+	client   SignerClient
+	registry codectypes.InterfaceRegistry
+	closeFn  func()
+
+	ctx     context.Context
+	timeout time.Duration
+}
+
+// NewRemoteSigner connects to the signer daemon listening on target, for example:
+// unix:///var/run/signer.sock or localhost:9091. The connection is insecure if no
+// dial option is provided, which is only suitable for the unix socket.
+func NewRemoteSigner(target string, opts ...grpc.DialOption) (*RemoteSigner, error) {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+
+	return &RemoteSigner{
+		conn:     conn,
+		client:   NewSignerClient(conn),
+		registry: registry,
+		ctx:      context.Background(),
+		timeout:  DefaultTimeout,
+	}, nil
+}
+
+// WithContext returns a copy of the signer whose calls are bound to ctx, the copy shares the connection
+func (r *RemoteSigner) WithContext(ctx context.Context) sdk.Signer {
+	c := *r
+	c.ctx = ctx
+	return &c
+}
+
+// WithTimeout returns a copy of the signer whose calls time out after timeout, 0 means no timeout
+func (r *RemoteSigner) WithTimeout(timeout time.Duration) *RemoteSigner {
+	c := *r
+	c.timeout = timeout
+	return &c
+}
+
+// Sign sends the sign bytes to the signer daemon and returns the signature and the public key,
+// the password is not sent out, as the keys are unlocked by the daemon itself
+func (r *RemoteSigner) Sign(name, _ string, data []byte) ([]byte, tmcrypto.PubKey, error) {
+	ctx, cancel := r.context()
+	defer cancel()
+
+	res, err := r.client.Sign(ctx, &SignRequest{
+		Name:      name,
+		SignBytes: data,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, err := r.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pubKey.VerifySignature(data, res.Signature) {
+		return nil, nil, fmt.Errorf("invalid signature returned by the signer of %s", name)
+	}
+	return res.Signature, pubKey, nil
+}
+
+// Find returns the public key and the address of the key of name
+func (r *RemoteSigner) Find(name, _ string) (tmcrypto.PubKey, sdk.AccAddress, error) {
+	ctx, cancel := r.context()
+	defer cancel()
+
+	res, err := r.client.PubKey(ctx, &PubKeyRequest{Name: name})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pubKey, err := r.unpackPubKey(res.PubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pubKey, sdk.AccAddress(pubKey.Address().Bytes()), nil
+}
+
+// Close closes the connection to the signer daemon
+func (r *RemoteSigner) Close() error {
+	if r.closeFn != nil {
+		defer r.closeFn()
+	}
+	return r.conn.Close()
+}
+
+func (r *RemoteSigner) context() (context.Context, context.CancelFunc) {
+	if r.timeout <= 0 {
+		return context.WithCancel(r.ctx)
+	}
+	return context.WithTimeout(r.ctx, r.timeout)
+}
+
+func (r *RemoteSigner) unpackPubKey(any *codectypes.Any) (tmcrypto.PubKey, error) {
+	if any == nil {
+		return nil, fmt.Errorf("no public key returned by the signer")
+	}
+
+	var pubKey tmcrypto.PubKey
+	if err := r.registry.UnpackAny(any, &pubKey); err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
@@ -0,0 +1,82 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var _ SignerServer = server{}
+
+// PasswordFunc returns the password used to unlock the key of name
+type PasswordFunc func(name string) string
+
+type server struct {
+	backend  sdk.Signer
+	password PasswordFunc
+}
+
+// NewServer returns the reference implementation of the signer daemon, which signs with the keys
+// of backend, for example the KeyManager of a client whose KeyDAO is only accessible to the daemon.
+// The server can be registered on a grpc.Server listening on a unix socket:
+//
+//	s := grpc.NewServer()
+//	signer.RegisterSignerServer(s, signer.NewServer(client.Key, password))
+//	s.Serve(lis)
+func NewServer(backend sdk.Signer, password PasswordFunc) SignerServer {
+	if password == nil {
+		password = func(string) string { return "" }
+	}
+	return server{
+		backend:  backend,
+		password: password,
+	}
+}
+
+func (s server) PubKey(_ context.Context, req *PubKeyRequest) (*PubKeyResponse, error) {
+	if req == nil || len(req.Name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key name")
+	}
+
+	pubKey, _, err := s.backend.Find(req.Name, s.password(req.Name))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	any, err := packPubKey(pubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &PubKeyResponse{PubKey: any}, nil
+}
+
+func (s server) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	if req == nil || len(req.Name) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty key name")
+	}
+
+	signature, pubKey, err := s.backend.Sign(req.Name, s.password(req.Name), req.SignBytes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	any, err := packPubKey(pubKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &SignResponse{Signature: signature, PubKey: any}, nil
+}
+
+func packPubKey(pubKey interface{}) (*codectypes.Any, error) {
+	msg, ok := pubKey.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("can't proto marshal %T", pubKey)
+	}
+	return codectypes.NewAnyWithValue(msg)
+}
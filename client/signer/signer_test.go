@@ -0,0 +1,87 @@
+package signer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub-sdk-go/client/signer"
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// backend holds the private keys on the daemon side
+type backend map[string]crypto.KeyManager
+
+func (b backend) Sign(name, password string, data []byte) ([]byte, tmcrypto.PubKey, error) {
+	km, ok := b[name]
+	if !ok || password != "12345678" {
+		return nil, nil, fmt.Errorf("name %s not exist", name)
+	}
+	sig, err := km.Sign(data)
+	return sig, km.ExportPubKey(), err
+}
+
+func (b backend) Find(name, password string) (tmcrypto.PubKey, sdk.AccAddress, error) {
+	km, ok := b[name]
+	if !ok || password != "12345678" {
+		return nil, nil, fmt.Errorf("name %s not exist", name)
+	}
+	return km.ExportPubKey(), sdk.AccAddress(km.ExportPubKey().Address()), nil
+}
+
+func TestInProcessSigner(t *testing.T) {
+	km, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+
+	remote, err := signer.NewInProcessSigner(backend{"test": km}, func(string) string { return "12345678" })
+	require.NoError(t, err)
+	defer remote.Close()
+
+	pubKey, addr, err := remote.Find("test", "")
+	require.NoError(t, err)
+	require.Equal(t, km.ExportPubKey(), pubKey)
+	require.Equal(t, sdk.AccAddress(km.ExportPubKey().Address()), addr)
+
+	data := []byte("sign bytes")
+	sig, pubKey, err := remote.Sign("test", "", data)
+	require.NoError(t, err)
+	require.Equal(t, km.ExportPubKey(), pubKey)
+	require.True(t, pubKey.VerifySignature(data, sig))
+
+	_, _, err = remote.Find("unknown", "")
+	require.Error(t, err)
+	_, _, err = remote.Sign("unknown", "", data)
+	require.Error(t, err)
+}
+
+func TestRemoteSignerContext(t *testing.T) {
+	km, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+
+	remote, err := signer.NewInProcessSigner(backend{"test": km}, func(string) string { return "12345678" })
+	require.NoError(t, err)
+	defer remote.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	bound := remote.WithContext(ctx)
+	_, _, err = bound.Find("test", "")
+	require.NoError(t, err)
+
+	// the calls are bound to the context of the client
+	cancel()
+	_, _, err = bound.Find("test", "")
+	require.Error(t, err)
+	_, _, err = bound.Sign("test", "", []byte("sign bytes"))
+	require.Error(t, err)
+
+	// and time out if the daemon doesn't answer in time
+	_, _, err = remote.WithTimeout(time.Nanosecond).Find("test", "")
+	require.Error(t, err)
+	_, _, err = remote.WithTimeout(0).Find("test", "")
+	require.NoError(t, err)
+}
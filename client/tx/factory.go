@@ -1,9 +1,12 @@
 package tx
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
+	"github.com/gogo/protobuf/jsonpb"
+
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
 )
@@ -24,12 +27,25 @@ type (
 		simulateAndExecute bool
 		fees               sdk.Coins
 		gasPrices          sdk.DecCoins
+		maxFees            sdk.Coins
 		mode               sdk.BroadcastMode
 		signMode           signing.SignMode
 		signModeHandler    sdk.SignModeHandler
-		keyManager         sdk.KeyManager
+		signer             sdk.Signer
 		txConfig           sdk.TxConfig
 		queryFunc          QueryWithData
+		feePayer           sdk.AccAddress
+		feeGranter         sdk.AccAddress
+		coSigners          []CoSigner
+	}
+
+	// CoSigner is an account signing the transaction after the signer of the Factory,
+	// such as a fee payer other than the sender.
+	CoSigner struct {
+		Name          string
+		Password      string
+		AccountNumber uint64
+		Sequence      uint64
 	}
 
 	// QueryWithData implements a query method from cschain.
@@ -53,6 +69,12 @@ func (f Factory) GasAdjustment() float64 { return f.gasAdjustment }
 // Fees returns the fee of the transaction.
 func (f *Factory) Fees() sdk.Coins { return f.fees }
 
+// GasPrices returns the gas prices used to derive the fee of the transaction.
+func (f *Factory) GasPrices() sdk.DecCoins { return f.gasPrices }
+
+// MaxFees returns the ceiling of the fee of the transaction.
+func (f *Factory) MaxFees() sdk.Coins { return f.maxFees }
+
 // Sequence returns the sequence of the account.
 func (f *Factory) Sequence() uint64 { return f.sequence }
 
@@ -62,8 +84,8 @@ func (f *Factory) Memo() string { return f.memo }
 // AccountNumber returns accountNumber.
 func (f *Factory) AccountNumber() uint64 { return f.accountNumber }
 
-// KeyManager returns keyManager.
-func (f *Factory) KeyManager() sdk.KeyManager { return f.keyManager }
+// Signer returns the signer used to sign the transaction.
+func (f *Factory) Signer() sdk.Signer { return f.signer }
 
 // Mode returns mode.
 func (f *Factory) Mode() sdk.BroadcastMode { return f.mode }
@@ -72,12 +94,24 @@ func (f *Factory) Mode() sdk.BroadcastMode { return f.mode }
 // using the gas from the simulation results
 func (f *Factory) SimulateAndExecute() bool { return f.simulateAndExecute }
 
+// SignMode returns the sign mode configured in the Factory
+func (f *Factory) SignMode() signing.SignMode { return f.signMode }
+
 // Password returns password.
 func (f *Factory) Password() string { return f.password }
 
 // Address returns the address.
 func (f *Factory) Address() string { return f.address }
 
+// FeePayer returns the account paying the fee, the first signer if empty.
+func (f *Factory) FeePayer() sdk.AccAddress { return f.feePayer }
+
+// FeeGranter returns the account whose fee allowance pays the fee.
+func (f *Factory) FeeGranter() sdk.AccAddress { return f.feeGranter }
+
+// CoSigners returns the accounts signing the transaction after the signer.
+func (f *Factory) CoSigners() []CoSigner { return f.coSigners }
+
 // WithChainID returns a pointer of the context with an updated ChainID.
 func (f *Factory) WithChainID(chainID string) *Factory {
 	f.chainID = chainID
@@ -102,6 +136,20 @@ func (f *Factory) WithFee(fee sdk.Coins) *Factory {
 	return f
 }
 
+// WithGasPrices returns a pointer of the context with updated gasPrices, the fee
+// is derived from them as ceil(gasPrice * gas).
+func (f *Factory) WithGasPrices(gasPrices sdk.DecCoins) *Factory {
+	f.gasPrices = gasPrices
+	return f
+}
+
+// WithMaxFees returns a pointer of the context with updated maxFees, building a
+// transaction whose fee exceeds them fails.
+func (f *Factory) WithMaxFees(maxFees sdk.Coins) *Factory {
+	f.maxFees = maxFees
+	return f
+}
+
 // WithSequence returns a pointer of the context with an updated sequence number.
 func (f *Factory) WithSequence(sequence uint64) *Factory {
 	f.sequence = sequence
@@ -120,9 +168,10 @@ func (f *Factory) WithAccountNumber(accnum uint64) *Factory {
 	return f
 }
 
-// WithKeyManager returns a pointer of the context with a KeyManager.
-func (f *Factory) WithKeyManager(keyManager sdk.KeyManager) *Factory {
-	f.keyManager = keyManager
+// WithSigner returns a pointer of the context with a Signer, which can be
+// a KeyManager or an external signer.
+func (f *Factory) WithSigner(signer sdk.Signer) *Factory {
+	f.signer = signer
 	return f
 }
 
@@ -138,6 +187,12 @@ func (f *Factory) WithSimulateAndExecute(simulate bool) *Factory {
 	return f
 }
 
+// WithSignMode returns a pointer of the context with a signMode.
+func (f *Factory) WithSignMode(signMode signing.SignMode) *Factory {
+	f.signMode = signMode
+	return f
+}
+
 // WithPassword returns a pointer of the context with a password.
 func (f *Factory) WithPassword(password string) *Factory {
 	f.password = password
@@ -168,7 +223,39 @@ func (f *Factory) WithQueryFunc(queryFunc QueryWithData) *Factory {
 	return f
 }
 
+// WithFeePayer returns a pointer of the context with a fee payer, which must
+// sign the transaction as well.
+func (f *Factory) WithFeePayer(feePayer sdk.AccAddress) *Factory {
+	f.feePayer = feePayer
+	return f
+}
+
+// WithFeeGranter returns a pointer of the context with a fee granter, whose fee
+// allowance granted to the fee payer pays the fee.
+func (f *Factory) WithFeeGranter(feeGranter sdk.AccAddress) *Factory {
+	f.feeGranter = feeGranter
+	return f
+}
+
+// WithCoSigners returns a pointer of the context with the accounts signing the
+// transaction after the signer, in the order of the signers of the transaction.
+func (f *Factory) WithCoSigners(coSigners ...CoSigner) *Factory {
+	f.coSigners = coSigners
+	return f
+}
+
+// BuildAndSign builds and signs the transaction. If simulateAndExecute is enabled,
+// the signed transaction is simulated first and then signed again with the
+// estimated gas multiplied by the gasAdjustment.
 func (f *Factory) BuildAndSign(name string, msgs []sdk.Msg, json bool) ([]byte, error) {
+	if f.simulateAndExecute {
+		gas, err := f.EstimateGas(name, msgs)
+		if err != nil {
+			return nil, err
+		}
+		f.WithGas(gas)
+	}
+
 	tx, err := f.BuildUnsignedTx(msgs)
 	if err != nil {
 		return nil, err
@@ -194,11 +281,59 @@ func (f *Factory) BuildAndSign(name string, msgs []sdk.Msg, json bool) ([]byte,
 	return txBytes, nil
 }
 
+// EstimateGas simulates the signed transaction with the queryFunc and returns
+// the gas used multiplied by the gasAdjustment.
+func (f *Factory) EstimateGas(name string, msgs []sdk.Msg) (uint64, error) {
+	if f.queryFunc == nil {
+		return 0, errors.New("query function required but not specified")
+	}
+
+	// the fee ceiling applies to the adjusted gas only
+	fees, err := f.calculateFees()
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := f.buildUnsignedTx(msgs, fees)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = f.Sign(name, tx); err != nil {
+		return 0, err
+	}
+
+	txBytes, err := f.txConfig.TxEncoder()(tx.GetTx())
+	if err != nil {
+		return 0, err
+	}
+
+	bz, _, err := f.queryFunc("/app/simulate", txBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	simRes, err := ParseSimulationResponse(bz)
+	if err != nil {
+		return 0, err
+	}
+	return AdjustGasEstimate(simRes.GasUsed, f.gasAdjustment), nil
+}
+
 func (f *Factory) BuildUnsignedTx(msgs []sdk.Msg) (sdk.TxBuilder, error) {
-	if f.chainID == "" {
-		return nil, fmt.Errorf("chain ID required but not specified")
+	fees, err := f.calculateFees()
+	if err != nil {
+		return nil, err
+	}
+
+	if !f.maxFees.Empty() && !fees.IsAllLTE(f.maxFees) {
+		return nil, fmt.Errorf("fees %s exceed the maximum fees %s", fees, f.maxFees)
 	}
+	return f.buildUnsignedTx(msgs, fees)
+}
 
+// calculateFees returns the fixed fees, or derives them from the gas prices when provided.
+func (f *Factory) calculateFees() (sdk.Coins, error) {
 	fees := f.fees
 
 	if !f.gasPrices.IsZero() {
@@ -217,6 +352,13 @@ func (f *Factory) BuildUnsignedTx(msgs []sdk.Msg) (sdk.TxBuilder, error) {
 			fees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 		}
 	}
+	return fees, nil
+}
+
+func (f *Factory) buildUnsignedTx(msgs []sdk.Msg, fees sdk.Coins) (sdk.TxBuilder, error) {
+	if f.chainID == "" {
+		return nil, fmt.Errorf("chain ID required but not specified")
+	}
 
 	tx := f.txConfig.NewTxBuilder()
 
@@ -227,29 +369,41 @@ func (f *Factory) BuildUnsignedTx(msgs []sdk.Msg) (sdk.TxBuilder, error) {
 	tx.SetMemo(f.memo)
 	tx.SetFeeAmount(fees)
 	tx.SetGasLimit(f.gas)
+
+	feeTx, ok := tx.(interface {
+		SetFeePayer(feePayer sdk.AccAddress)
+		SetFeeGranter(feeGranter sdk.AccAddress)
+	})
+	if (!f.feePayer.Empty() || !f.feeGranter.Empty()) && !ok {
+		return nil, fmt.Errorf("%T doesn't support fee payers", tx)
+	}
+	if !f.feePayer.Empty() {
+		feeTx.SetFeePayer(f.feePayer)
+	}
+	if !f.feeGranter.Empty() {
+		feeTx.SetFeeGranter(f.feeGranter)
+	}
 	//f.txBuilder.SetTimeoutHeight(f.TimeoutHeight())
 
 	return tx, nil
 }
 
 // Sign signs a transaction given a name, passphrase, and a single message to
-// signed. An error is returned if signing fails.
+// signed, followed by the co-signers of the Factory. An error is returned if
+// signing fails.
 func (f *Factory) Sign(name string, txBuilder sdk.TxBuilder) error {
 	signMode := f.signMode
 	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
 		// use the SignModeHandler's default mode if unspecified
 		signMode = f.txConfig.SignModeHandler().DefaultMode()
 	}
-	signerData := sdk.SignerData{
-		ChainID:       f.chainID,
+
+	signers := append([]CoSigner{{
+		Name:          name,
+		Password:      f.password,
 		AccountNumber: f.accountNumber,
 		Sequence:      f.sequence,
-	}
-
-	pubkey, _, err := f.keyManager.Find(name, f.password)
-	if err != nil {
-		return err
-	}
+	}}, f.coSigners...)
 
 	// For SIGN_MODE_DIRECT, calling SetSignatures calls setSignerInfos on
 	// Factory under the hood, and SignerInfos is needed to generated the
@@ -259,42 +413,69 @@ func (f *Factory) Sign(name string, txBuilder sdk.TxBuilder) error {
 	// Note: this line is not needed for SIGN_MODE_LEGACY_AMINO, but putting it
 	// also doesn't affect its generated sign bytes, so for code's simplicity
 	// sake, we put it here.
-	sigData := signing.SingleSignatureData{
-		SignMode:  signMode,
-		Signature: nil,
-	}
-	sig := signing.SignatureV2{
-		PubKey:   pubkey,
-		Data:     &sigData,
-		Sequence: f.Sequence(),
+	sigs := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		pubkey, _, err := f.signer.Find(signer.Name, signer.Password)
+		if err != nil {
+			return err
+		}
+		sigs[i] = signing.SignatureV2{
+			PubKey: pubkey,
+			Data: &signing.SingleSignatureData{
+				SignMode:  signMode,
+				Signature: nil,
+			},
+			Sequence: signer.Sequence,
+		}
 	}
-	if err := txBuilder.SetSignatures(sig); err != nil {
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
 		return err
 	}
 
-	// Generate the bytes to be signed.
-	signBytes, err := f.signModeHandler.GetSignBytes(signMode, signerData, txBuilder.GetTx())
-	if err != nil {
-		return err
-	}
+	for i, signer := range signers {
+		signerData := sdk.SignerData{
+			ChainID:       f.chainID,
+			AccountNumber: signer.AccountNumber,
+			Sequence:      signer.Sequence,
+		}
 
-	// Sign those bytes
-	sigBytes, _, err := f.keyManager.Sign(name, f.password, signBytes)
-	if err != nil {
-		return err
-	}
+		// Generate the bytes to be signed.
+		signBytes, err := f.signModeHandler.GetSignBytes(signMode, signerData, txBuilder.GetTx())
+		if err != nil {
+			return err
+		}
+
+		// Sign those bytes
+		sigBytes, _, err := f.signer.Sign(signer.Name, signer.Password, signBytes)
+		if err != nil {
+			return err
+		}
 
-	// Construct the SignatureV2 struct
-	sigData = signing.SingleSignatureData{
-		SignMode:  signMode,
-		Signature: sigBytes,
+		// Construct the SignatureV2 struct
+		sigs[i].Data = &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: sigBytes,
+		}
 	}
-	sig = signing.SignatureV2{
-		PubKey:   pubkey,
-		Data:     &sigData,
-		Sequence: f.Sequence(),
+
+	// And here the tx is populated with the signatures
+	return txBuilder.SetSignatures(sigs...)
+}
+
+// AdjustGasEstimate multiplies the simulated gas by the adjustment factor,
+// an adjustment that is not positive leaves the estimate unchanged.
+func AdjustGasEstimate(estimate uint64, adjustment float64) uint64 {
+	if adjustment <= 0 {
+		return estimate
 	}
+	return uint64(adjustment * float64(estimate))
+}
 
-	// And here the tx is populated with the signature
-	return txBuilder.SetSignatures(sig)
+// ParseSimulationResponse decodes the json response of the "/app/simulate" query.
+func ParseSimulationResponse(bz []byte) (sdk.SimulationResponse, error) {
+	var simRes sdk.SimulationResponse
+	if err := jsonpb.Unmarshal(bytes.NewReader(bz), &simRes); err != nil {
+		return sdk.SimulationResponse{}, err
+	}
+	return simRes, nil
 }
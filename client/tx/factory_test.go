@@ -0,0 +1,219 @@
+package tx_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttx "github.com/irisnet/irishub-sdk-go/client/tx"
+	"github.com/irisnet/irishub-sdk-go/codec"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
+)
+
+func TestSignLegacyAminoJSON(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	bank.RegisterInterfaces(registry)
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txConfig := txtypes.NewTxConfig(codec.NewProtoCodec(registry), txtypes.DefaultSignModes)
+
+	k, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+	km := memSigner{"a": k}
+	addr := sdk.AccAddress(k.ExportPubKey().Address())
+
+	factory := clienttx.NewFactory().
+		WithChainID("irishub").
+		WithAccountNumber(3).
+		WithSequence(7).
+		WithGas(200000).
+		WithFee(sdk.NewCoins(sdk.NewInt64Coin("uiris", 4000))).
+		WithMemo("memo").
+		WithSigner(km).
+		WithTxConfig(txConfig).
+		WithSignModeHandler(txtypes.MakeSignModeHandler(txtypes.DefaultSignModes)).
+		WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	txBuilder, err := factory.BuildUnsignedTx([]sdk.Msg{msg})
+	require.NoError(t, err)
+	require.NoError(t, factory.Sign("a", txBuilder))
+
+	signerData := sdk.SignerData{ChainID: "irishub", AccountNumber: 3, Sequence: 7}
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	expected := `{"account_number":"3","chain_id":"irishub","fee":{"amount":[{"amount":"4000","denom":"uiris"}],"gas":"200000"},"memo":"memo",` +
+		`"msgs":[{"type":"cosmos-sdk/MsgSend","value":{"amount":[{"amount":"1","denom":"uiris"}],"from_address":"` + addr.String() +
+		`","to_address":"` + addr.String() + `"}}],"sequence":"7"}`
+	require.Equal(t, expected, string(signBytes))
+
+	sigTx := txBuilder.GetTx().(interface {
+		GetSignaturesV2() ([]signing.SignatureV2, error)
+	})
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+
+	sigData, ok := sigs[0].Data.(*signing.SingleSignatureData)
+	require.True(t, ok)
+	require.Equal(t, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, sigData.SignMode)
+	require.True(t, k.ExportPubKey().VerifySignature(signBytes, sigData.Signature))
+}
+
+func TestBuildAndSignAutoGas(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	bank.RegisterInterfaces(registry)
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txConfig := txtypes.NewTxConfig(codec.NewProtoCodec(registry), txtypes.DefaultSignModes)
+
+	k, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+	addr := sdk.AccAddress(k.ExportPubKey().Address())
+
+	var simulated []byte
+	queryFunc := func(path string, data []byte) ([]byte, int64, error) {
+		require.Equal(t, "/app/simulate", path)
+		simulated = data
+		return []byte(`{"gas_info":{"gas_wanted":"200000","gas_used":"50000"}}`), 1, nil
+	}
+
+	factory := clienttx.NewFactory().
+		WithChainID("irishub").
+		WithAccountNumber(3).
+		WithSequence(7).
+		WithGas(200000).
+		WithGasAdjustment(1.5).
+		WithSimulateAndExecute(true).
+		WithQueryFunc(queryFunc).
+		WithFee(sdk.NewCoins(sdk.NewInt64Coin("uiris", 4000))).
+		WithSigner(memSigner{"a": k}).
+		WithTxConfig(txConfig).
+		WithSignModeHandler(txtypes.MakeSignModeHandler(txtypes.DefaultSignModes))
+
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	txBytes, err := factory.BuildAndSign("a", []sdk.Msg{msg}, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(75000), factory.Gas())
+
+	// the simulated tx is signed with the configured gas
+	decoded, err := txConfig.TxDecoder()(simulated)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200000), decoded.(sdk.FeeTx).GetGas())
+
+	decoded, err = txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, uint64(75000), decoded.(sdk.FeeTx).GetGas())
+
+	sigTx := decoded.(interface {
+		GetSignaturesV2() ([]signing.SignatureV2, error)
+	})
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 1)
+}
+
+func TestBuildUnsignedTxGasPrices(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	bank.RegisterInterfaces(registry)
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txConfig := txtypes.NewTxConfig(codec.NewProtoCodec(registry), txtypes.DefaultSignModes)
+
+	addr := sdk.AccAddress("addr")
+	msg := bank.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+
+	factory := clienttx.NewFactory().
+		WithChainID("irishub").
+		WithGas(100001).
+		WithGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1)))).
+		WithTxConfig(txConfig)
+
+	// fee = ceil(0.2 * 100001)
+	txBuilder, err := factory.BuildUnsignedTx([]sdk.Msg{msg})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uiris", 20001)), txBuilder.GetTx().(sdk.FeeTx).GetFee())
+
+	factory.WithMaxFees(sdk.NewCoins(sdk.NewInt64Coin("uiris", 20001)))
+	_, err = factory.BuildUnsignedTx([]sdk.Msg{msg})
+	require.NoError(t, err)
+
+	factory.WithMaxFees(sdk.NewCoins(sdk.NewInt64Coin("uiris", 20000)))
+	_, err = factory.BuildUnsignedTx([]sdk.Msg{msg})
+	require.Error(t, err)
+
+	// fixed fees and gas prices are exclusive
+	factory.WithMaxFees(nil).WithFee(sdk.NewCoins(sdk.NewInt64Coin("uiris", 4000)))
+	_, err = factory.BuildUnsignedTx([]sdk.Msg{msg})
+	require.Error(t, err)
+}
+
+func TestSignFeePayer(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	bank.RegisterInterfaces(registry)
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txConfig := txtypes.NewTxConfig(codec.NewProtoCodec(registry), txtypes.DefaultSignModes)
+
+	km := memSigner{}
+	for _, name := range []string{"sender", "payer"} {
+		k, err := crypto.NewAlgoKeyManager("secp256k1")
+		require.NoError(t, err)
+		km[name] = k
+	}
+	sender := sdk.AccAddress(km["sender"].ExportPubKey().Address())
+	payer := sdk.AccAddress(km["payer"].ExportPubKey().Address())
+	granter := sdk.AccAddress("granter")
+
+	factory := clienttx.NewFactory().
+		WithChainID("irishub").
+		WithAccountNumber(3).
+		WithSequence(7).
+		WithGas(200000).
+		WithFee(sdk.NewCoins(sdk.NewInt64Coin("uiris", 4000))).
+		WithSigner(km).
+		WithTxConfig(txConfig).
+		WithSignModeHandler(txtypes.MakeSignModeHandler(txtypes.DefaultSignModes)).
+		WithFeePayer(payer).
+		WithFeeGranter(granter).
+		WithCoSigners(clienttx.CoSigner{Name: "payer", AccountNumber: 5, Sequence: 2})
+
+	msg := bank.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	txBytes, err := factory.BuildAndSign("sender", []sdk.Msg{msg}, false)
+	require.NoError(t, err)
+
+	decoded, err := txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+	require.NoError(t, decoded.ValidateBasic())
+
+	feeTx := decoded.(sdk.FeeTx)
+	require.Equal(t, payer, feeTx.FeePayer())
+	require.Equal(t, granter, feeTx.FeeGranter())
+
+	// the fee payer signs after the signers of the msgs, with its own account number and sequence
+	sigTx := decoded.(interface {
+		GetSigners() []sdk.AccAddress
+		GetSignaturesV2() ([]signing.SignatureV2, error)
+	})
+	require.Equal(t, []sdk.AccAddress{sender, payer}, sigTx.GetSigners())
+	sigs, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+
+	for i, signerData := range []sdk.SignerData{
+		{ChainID: "irishub", AccountNumber: 3, Sequence: 7},
+		{ChainID: "irishub", AccountNumber: 5, Sequence: 2},
+	} {
+		require.Equal(t, signerData.Sequence, sigs[i].Sequence)
+		sigData := sigs[i].Data.(*signing.SingleSignatureData)
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(sigData.SignMode, signerData, decoded)
+		require.NoError(t, err)
+		require.True(t, sigs[i].PubKey.VerifySignature(signBytes, sigData.Signature))
+	}
+}
@@ -0,0 +1,140 @@
+package tx
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub-sdk-go/crypto/types/multisig"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
+)
+
+// multisigSignMode is the only sign mode that can be used by the members of a
+// multisig account: the SIGN_MODE_DIRECT sign bytes cover the signer infos, which
+// are unknown until all partial signatures have been collected.
+const multisigSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// GenerateOnly builds an unsigned transaction and returns its JSON encoding, which
+// can be passed to the co-signers of a multisig account.
+func (f *Factory) GenerateOnly(msgs []sdk.Msg) ([]byte, error) {
+	tx, err := f.BuildUnsignedTx(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return f.txConfig.TxJSONEncoder()(tx.GetTx())
+}
+
+// SignMultisig signs the transaction with the key of name on behalf of a multisig
+// account. The account number and sequence of the Factory must be the ones of the
+// multisig account. The returned signature is one part of the final multi-signature.
+func (f *Factory) SignMultisig(name string, txBuilder sdk.TxBuilder) (signing.SignatureV2, error) {
+	pubkey, _, err := f.signer.Find(name, f.password)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	signBytes, err := f.multisigSignBytes(txBuilder)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	sigBytes, _, err := f.signer.Sign(name, f.password, signBytes)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	return signing.SignatureV2{
+		PubKey: pubkey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  multisigSignMode,
+			Signature: sigBytes,
+		},
+		Sequence: f.Sequence(),
+	}, nil
+}
+
+// MultiSign verifies the partial signatures produced by SignMultisig, combines them
+// into a MultiSignatureData of the multisig public key and populates the transaction with it.
+func (f *Factory) MultiSign(multisigPubKey crypto.PubKey, txBuilder sdk.TxBuilder, sigs ...signing.SignatureV2) error {
+	multisigPub, ok := multisigPubKey.(multisig.PubKey)
+	if !ok {
+		return fmt.Errorf("%T is not a multisig public key", multisigPubKey)
+	}
+
+	signBytes, err := f.multisigSignBytes(txBuilder)
+	if err != nil {
+		return err
+	}
+
+	pubKeys := multisigPub.GetPubKeys()
+	multisigSig := multisig.NewMultisig(len(pubKeys))
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != multisigSignMode {
+			return fmt.Errorf("signature of %s must be signed with %s", sig.PubKey.Address(), multisigSignMode)
+		}
+
+		if !sig.PubKey.VerifySignature(signBytes, data.Signature) {
+			return fmt.Errorf("couldn't verify signature of %s", sig.PubKey.Address())
+		}
+
+		if err := multisig.AddSignatureV2(multisigSig, sig, pubKeys); err != nil {
+			return err
+		}
+	}
+
+	if len(multisigSig.Signatures) < int(multisigPub.GetThreshold()) {
+		return fmt.Errorf("insufficient signatures, expected %d, got %d",
+			multisigPub.GetThreshold(), len(multisigSig.Signatures))
+	}
+
+	return txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   multisigPub,
+		Data:     multisigSig,
+		Sequence: f.Sequence(),
+	})
+}
+
+// CombineSignatures populates the transaction with the signatures of its several signers,
+// such as the sender and a fee payer, each produced by SignMultisig with the account number
+// and sequence of the signer. The signatures are ordered as the signers of the transaction.
+func (f *Factory) CombineSignatures(txBuilder sdk.TxBuilder, sigs ...signing.SignatureV2) error {
+	bySigner := make(map[string]signing.SignatureV2, len(sigs))
+	for _, sig := range sigs {
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != multisigSignMode {
+			return fmt.Errorf("signature of %s must be signed with %s", sig.PubKey.Address(), multisigSignMode)
+		}
+		bySigner[sdk.AccAddress(sig.PubKey.Address()).String()] = sig
+	}
+
+	tx, ok := txBuilder.GetTx().(interface{ GetSigners() []sdk.AccAddress })
+	if !ok {
+		return fmt.Errorf("%T doesn't expose its signers", txBuilder.GetTx())
+	}
+
+	signers := tx.GetSigners()
+	if len(sigs) != len(signers) {
+		return fmt.Errorf("wrong number of signatures, expected %d, got %d", len(signers), len(sigs))
+	}
+
+	ordered := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		sig, ok := bySigner[signer.String()]
+		if !ok {
+			return fmt.Errorf("missing the signature of %s", signer)
+		}
+		ordered[i] = sig
+	}
+	return txBuilder.SetSignatures(ordered...)
+}
+
+func (f *Factory) multisigSignBytes(txBuilder sdk.TxBuilder) ([]byte, error) {
+	signerData := sdk.SignerData{
+		ChainID:       f.chainID,
+		AccountNumber: f.accountNumber,
+		Sequence:      f.sequence,
+	}
+	return f.signModeHandler.GetSignBytes(multisigSignMode, signerData, txBuilder.GetTx())
+}
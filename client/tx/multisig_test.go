@@ -0,0 +1,206 @@
+package tx_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	clienttx "github.com/irisnet/irishub-sdk-go/client/tx"
+	"github.com/irisnet/irishub-sdk-go/codec"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	kmultisig "github.com/irisnet/irishub-sdk-go/crypto/keys/multisig"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
+)
+
+// memSigner is a minimal sdk.Signer holding the private keys in memory
+type memSigner map[string]crypto.KeyManager
+
+func (m memSigner) Sign(name, _ string, data []byte) ([]byte, tmcrypto.PubKey, error) {
+	km, ok := m[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("name %s not exist", name)
+	}
+	sig, err := km.Sign(data)
+	return sig, km.ExportPubKey(), err
+}
+
+func (m memSigner) Find(name, _ string) (tmcrypto.PubKey, sdk.AccAddress, error) {
+	km, ok := m[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("name %s not exist", name)
+	}
+	return km.ExportPubKey(), sdk.AccAddress(km.ExportPubKey().Address()), nil
+}
+
+func TestMultiSign(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	bank.RegisterInterfaces(registry)
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txConfig := txtypes.NewTxConfig(codec.NewProtoCodec(registry), txtypes.DefaultSignModes)
+
+	km := memSigner{}
+	var pubKeys []tmcrypto.PubKey
+	for _, name := range []string{"a", "b", "c"} {
+		k, err := crypto.NewAlgoKeyManager("secp256k1")
+		require.NoError(t, err)
+		km[name] = k
+		pubKeys = append(pubKeys, k.ExportPubKey())
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+	newFactory := func() *clienttx.Factory {
+		return clienttx.NewFactory().
+			WithChainID("irishub").
+			WithAccountNumber(3).
+			WithSequence(7).
+			WithGas(200000).
+			WithFee(sdk.NewCoins(sdk.NewInt64Coin("uiris", 4000))).
+			WithSigner(km).
+			WithTxConfig(txConfig).
+			WithSignModeHandler(txtypes.MakeSignModeHandler(txtypes.DefaultSignModes))
+	}
+
+	msg := bank.NewMsgSend(multisigAddr, sdk.AccAddress(pubKeys[0].Address()), sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	unsignedTx, err := newFactory().GenerateOnly([]sdk.Msg{msg})
+	require.NoError(t, err)
+
+	// every co-signer works on its own copy of the unsigned tx
+	var partialSigs [][]byte
+	for _, name := range []string{"c", "a"} {
+		decoded, err := txConfig.TxJSONDecoder()(unsignedTx)
+		require.NoError(t, err)
+		txBuilder, err := txConfig.WrapTxBuilder(decoded)
+		require.NoError(t, err)
+
+		sig, err := newFactory().SignMultisig(name, txBuilder)
+		require.NoError(t, err)
+
+		bz, err := txConfig.MarshalSignatureJSON([]signing.SignatureV2{sig})
+		require.NoError(t, err)
+		partialSigs = append(partialSigs, bz)
+	}
+
+	decoded, err := txConfig.TxJSONDecoder()(unsignedTx)
+	require.NoError(t, err)
+	txBuilder, err := txConfig.WrapTxBuilder(decoded)
+	require.NoError(t, err)
+
+	var sigs []signing.SignatureV2
+	for _, bz := range partialSigs {
+		sig, err := txConfig.UnmarshalSignatureJSON(bz)
+		require.NoError(t, err)
+		sigs = append(sigs, sig...)
+	}
+
+	// a single signature doesn't reach the threshold
+	require.Error(t, newFactory().MultiSign(multisigPub, txBuilder, sigs[0]))
+	require.NoError(t, newFactory().MultiSign(multisigPub, txBuilder, sigs...))
+
+	// the signed tx survives a json round trip and carries a valid multi-signature
+	signedTx, err := txConfig.TxJSONEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+	decoded, err = txConfig.TxJSONDecoder()(signedTx)
+	require.NoError(t, err)
+	txBytes, err := txConfig.TxEncoder()(decoded)
+	require.NoError(t, err)
+	decoded, err = txConfig.TxDecoder()(txBytes)
+	require.NoError(t, err)
+
+	sigTx := decoded.(interface {
+		GetSignaturesV2() ([]signing.SignatureV2, error)
+	})
+	sigsV2, err := sigTx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigsV2, 1)
+	require.Equal(t, uint64(7), sigsV2[0].Sequence)
+
+	multiSigData, ok := sigsV2[0].Data.(*signing.MultiSignatureData)
+	require.True(t, ok)
+
+	signerData := sdk.SignerData{ChainID: "irishub", AccountNumber: 3, Sequence: 7}
+	err = multisigPub.VerifyMultisignature(func(mode signing.SignMode) ([]byte, error) {
+		return txConfig.SignModeHandler().GetSignBytes(mode, signerData, decoded)
+	}, multiSigData)
+	require.NoError(t, err)
+}
+
+func TestCombineSignatures(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	bank.RegisterInterfaces(registry)
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	txConfig := txtypes.NewTxConfig(codec.NewProtoCodec(registry), txtypes.DefaultSignModes)
+
+	km := memSigner{}
+	for _, name := range []string{"sender", "payer"} {
+		k, err := crypto.NewAlgoKeyManager("secp256k1")
+		require.NoError(t, err)
+		km[name] = k
+	}
+	sender := sdk.AccAddress(km["sender"].ExportPubKey().Address())
+	payer := sdk.AccAddress(km["payer"].ExportPubKey().Address())
+
+	newFactory := func(accountNumber, sequence uint64) *clienttx.Factory {
+		return clienttx.NewFactory().
+			WithChainID("irishub").
+			WithAccountNumber(accountNumber).
+			WithSequence(sequence).
+			WithGas(200000).
+			WithSigner(km).
+			WithTxConfig(txConfig).
+			WithSignModeHandler(txtypes.MakeSignModeHandler(txtypes.DefaultSignModes))
+	}
+
+	msg := bank.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1)))
+	unsignedTx, err := newFactory(0, 0).WithFeePayer(payer).GenerateOnly([]sdk.Msg{msg})
+	require.NoError(t, err)
+
+	// each signer signs offline with its own account number and sequence
+	sign := func(name string, accountNumber, sequence uint64) signing.SignatureV2 {
+		decoded, err := txConfig.TxJSONDecoder()(unsignedTx)
+		require.NoError(t, err)
+		txBuilder, err := txConfig.WrapTxBuilder(decoded)
+		require.NoError(t, err)
+		sig, err := newFactory(accountNumber, sequence).SignMultisig(name, txBuilder)
+		require.NoError(t, err)
+		return sig
+	}
+	senderSig := sign("sender", 3, 7)
+	payerSig := sign("payer", 5, 2)
+
+	decoded, err := txConfig.TxJSONDecoder()(unsignedTx)
+	require.NoError(t, err)
+	txBuilder, err := txConfig.WrapTxBuilder(decoded)
+	require.NoError(t, err)
+
+	require.Error(t, newFactory(0, 0).CombineSignatures(txBuilder, payerSig))
+	require.Error(t, newFactory(0, 0).CombineSignatures(txBuilder, payerSig, payerSig))
+
+	// the signatures are ordered as the signers
+	require.NoError(t, newFactory(0, 0).CombineSignatures(txBuilder, payerSig, senderSig))
+	require.NoError(t, txBuilder.GetTx().ValidateBasic())
+
+	sigs, err := txBuilder.GetTx().(interface {
+		GetSignaturesV2() ([]signing.SignatureV2, error)
+	}).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, 2)
+	for i, signerData := range []sdk.SignerData{
+		{ChainID: "irishub", AccountNumber: 3, Sequence: 7},
+		{ChainID: "irishub", AccountNumber: 5, Sequence: 2},
+	} {
+		signBytes, err := txConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txBuilder.GetTx())
+		require.NoError(t, err)
+		require.True(t, sigs[i].PubKey.VerifySignature(signBytes, sigs[i].Data.(*signing.SingleSignatureData).Signature))
+	}
+}
@@ -0,0 +1,127 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	"github.com/irisnet/irishub-sdk-go/modules/record"
+	"github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+// the SIGN_MODE_LEGACY_AMINO_JSON sign bytes of every msg must carry the amino envelope of the msg
+func TestMsgsAminoJSONSignBytes(t *testing.T) {
+	cfg, err := types.NewClientConfig("tcp://127.0.0.1:26657", "127.0.0.1:9090", "irishub",
+		types.KeyDAOOption(store.NewMemory(nil)))
+	require.NoError(t, err)
+	client := NewIRISHUBClient(cfg)
+
+	registry := client.encodingConfig.InterfaceRegistry
+	typeURLs := registry.ListImplementations("cosmos.v1beta1.Msg")
+	require.NotEmpty(t, typeURLs)
+	for _, typeURL := range typeURLs {
+		resolved, err := registry.Resolve(typeURL)
+		require.NoError(t, err, typeURL)
+		msg, ok := resolved.(types.Msg)
+		require.True(t, ok, typeURL)
+
+		var envelope struct {
+			Type  string          `json:"type"`
+			Value json.RawMessage `json:"value"`
+		}
+		require.NoError(t, json.Unmarshal(msg.GetSignBytes(), &envelope), typeURL)
+		require.NotEmpty(t, envelope.Type, typeURL)
+		require.NotNil(t, envelope.Value, typeURL)
+	}
+}
+
+// the collected calls return ErrMsgCollected before reading the events of their tx, and only the
+// granters given to CollectMsgs are found without a key
+func TestCollectMsgs(t *testing.T) {
+	cfg, err := types.NewClientConfig("tcp://127.0.0.1:26657", "127.0.0.1:9090", "irishub",
+		types.KeyDAOOption(store.NewMemory(nil)))
+	require.NoError(t, err)
+	client := NewIRISHUBClient(cfg)
+
+	creator := types.AccAddress(make([]byte, 20)).String()
+	request := record.CreateRecordRequest{
+		Contents: []record.Content{{Digest: "digest", DigestAlgo: "sha256"}},
+	}
+	msgs, err := client.CollectMsgs(func(c IRISHUBClient) error {
+		for i := 0; i < 2; i++ {
+			if _, err := c.Record.CreateRecord(request, types.BaseTx{From: creator}); !types.IsMsgCollected(err) {
+				return err
+			}
+		}
+		return nil
+	}, creator)
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	require.Equal(t, creator, msgs[0].(*record.MsgCreateRecord).Creator)
+
+	_, err = client.CollectMsgs(func(c IRISHUBClient) error {
+		_, err := c.Record.CreateRecord(request, types.BaseTx{From: creator})
+		return err
+	})
+	require.Error(t, err)
+}
+
+// the msgs wrapped by MsgExec must keep their amino envelope in its sign bytes
+func TestMsgExecSignBytes(t *testing.T) {
+	voter := types.AccAddress(make([]byte, 20))
+	vote := &gov.MsgVote{ProposalId: 1, Voter: voter.String(), Option: gov.OptionYes}
+	msg, err := authz.NewMsgExec(voter, []types.Msg{vote})
+	require.NoError(t, err)
+
+	var signDoc struct {
+		Value struct {
+			Msgs []struct {
+				Type  string          `json:"type"`
+				Value json.RawMessage `json:"value"`
+			} `json:"msgs"`
+		} `json:"value"`
+	}
+	require.NoError(t, json.Unmarshal(msg.GetSignBytes(), &signDoc))
+	require.Len(t, signDoc.Value.Msgs, 1)
+	require.Equal(t, "cosmos-sdk/MsgVote", signDoc.Value.Msgs[0].Type)
+	require.JSONEq(t, string(vote.GetSignBytes()), `{"type":"cosmos-sdk/MsgVote","value":`+string(signDoc.Value.Msgs[0].Value)+`}`)
+}
+
+// every msg registered on the client must be known to the codec of the MsgExec sign bytes
+func TestMsgExecSignBytesOfAllMsgs(t *testing.T) {
+	cfg, err := types.NewClientConfig("tcp://127.0.0.1:26657", "127.0.0.1:9090", "irishub",
+		types.KeyDAOOption(store.NewMemory(nil)))
+	require.NoError(t, err)
+	client := NewIRISHUBClient(cfg)
+
+	registry := client.encodingConfig.InterfaceRegistry
+	for _, typeURL := range registry.ListImplementations("cosmos.v1beta1.Msg") {
+		resolved, err := registry.Resolve(typeURL)
+		require.NoError(t, err, typeURL)
+		msg := resolved.(types.Msg)
+
+		var envelope struct {
+			Type string `json:"type"`
+		}
+		require.NoError(t, json.Unmarshal(msg.GetSignBytes(), &envelope), typeURL)
+
+		exec, err := authz.NewMsgExec(types.AccAddress(make([]byte, 20)), []types.Msg{msg})
+		require.NoError(t, err, typeURL)
+		var signDoc struct {
+			Value struct {
+				Msgs []struct {
+					Type string `json:"type"`
+				} `json:"msgs"`
+			} `json:"value"`
+		}
+		require.NotPanics(t, func() {
+			require.NoError(t, json.Unmarshal(exec.GetSignBytes(), &signDoc), typeURL)
+		}, typeURL)
+		require.Len(t, signDoc.Value.Msgs, 1, typeURL)
+		require.Equal(t, envelope.Type, signDoc.Value.Msgs[0].Type, typeURL)
+	}
+}
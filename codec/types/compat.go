@@ -172,6 +172,10 @@ type AminoJSONPacker struct {
 var _ AnyUnpacker = AminoJSONPacker{}
 
 func (a AminoJSONPacker) UnpackAny(any *Any, _ interface{}) error {
+	if any == nil {
+		return nil
+	}
+
 	err := UnpackInterfaces(any.cachedValue, a)
 	if err != nil {
 		return err
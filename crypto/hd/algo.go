@@ -2,11 +2,16 @@ package hd
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	bip39 "github.com/cosmos/go-bip39"
 	"github.com/tendermint/tendermint/crypto"
+	goed25519 "golang.org/x/crypto/ed25519"
 
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/ed25519"
 	"github.com/irisnet/irishub-sdk-go/crypto/keys/secp256k1"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
 )
 
 type SignatureAlgo interface {
@@ -19,6 +24,10 @@ func NewSigningAlgoFromString(str string) (SignatureAlgo, error) {
 	switch str {
 	case string(Secp256k1.Name()):
 		return Secp256k1, nil
+	case string(Ed25519.Name()):
+		return Ed25519, nil
+	case string(Sm2.Name()):
+		return Sm2, nil
 	default:
 		return nil, fmt.Errorf("provided algorithm `%s` is not supported", str)
 	}
@@ -33,8 +42,9 @@ const (
 	// Secp256k1Type uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1Type = PubKeyType("secp256k1")
 	// Ed25519Type represents the Ed25519Type signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
 	Ed25519Type = PubKeyType("ed25519")
+	// Sm2Type represents the SM2 signature system of the Chinese national standard.
+	Sm2Type = PubKeyType("sm2")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
 )
@@ -42,6 +52,10 @@ const (
 var (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = secp256k1Algo{}
+	// Ed25519 uses the Ed25519 signature system.
+	Ed25519 = ed25519Algo{}
+	// Sm2 uses the SM2 signature system.
+	Sm2 = sm2Algo{}
 )
 
 type DeriveFn func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error)
@@ -61,20 +75,7 @@ func (s secp256k1Algo) Name() PubKeyType {
 
 // Derive derives and returns the secp256k1 private key for the given seed and HD path.
 func (s secp256k1Algo) Derive() DeriveFn {
-	return func(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
-		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
-		if err != nil {
-			return nil, err
-		}
-
-		masterPriv, ch := ComputeMastersFromSeed(seed)
-		if len(hdPath) == 0 {
-			return masterPriv[:], nil
-		}
-		derivedKey, err := DerivePrivateKeyForPath(masterPriv, ch, hdPath)
-
-		return derivedKey, err
-	}
+	return deriveBIP44
 }
 
 // Generate generates a secp256k1 private key from the given bytes.
@@ -86,3 +87,93 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: bzArr}
 	}
 }
+
+type ed25519Algo struct {
+}
+
+func (s ed25519Algo) Name() PubKeyType {
+	return Ed25519Type
+}
+
+// Derive derives and returns the 32 bytes seed of the ed25519 private key for the given seed and HD path,
+// following SLIP-10 as the BIP32 derivation of secp256k1 doesn't apply to the ed25519 curve.
+func (s ed25519Algo) Derive() DeriveFn {
+	return deriveSLIP10
+}
+
+// Generate generates an ed25519 private key from the given seed.
+func (s ed25519Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		var seed = make([]byte, ed25519.SeedSize)
+		copy(seed, bz)
+
+		return &ed25519.PrivKey{Key: goed25519.NewKeyFromSeed(seed)}
+	}
+}
+
+type sm2Algo struct {
+}
+
+func (s sm2Algo) Name() PubKeyType {
+	return Sm2Type
+}
+
+// Derive derives and returns the sm2 private key for the given seed and HD path.
+func (s sm2Algo) Derive() DeriveFn {
+	return deriveBIP44
+}
+
+// Generate generates a sm2 private key from the given bytes.
+func (s sm2Algo) Generate() GenerateFn {
+	return func(bz []byte) crypto.PrivKey {
+		return sm2.GenPrivKeyFromSecret(bz)
+	}
+}
+
+// deriveBIP44 derives the 32 bytes private key for the given seed and HD path,
+// it's shared by secp256k1 and sm2 so that the same mnemonic derives the same bytes
+func deriveBIP44(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	masterPriv, ch := ComputeMastersFromSeed(seed)
+	if len(hdPath) == 0 {
+		return masterPriv[:], nil
+	}
+	derivedKey, err := DerivePrivateKeyForPath(masterPriv, ch, hdPath)
+
+	return derivedKey, err
+}
+
+// deriveSLIP10 derives the 32 bytes ed25519 seed for the given mnemonic and HD path following SLIP-10,
+// see https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+func deriveSLIP10(mnemonic string, bip39Passphrase, hdPath string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return deriveEd25519KeyForPath(seed, hdPath)
+}
+
+// deriveEd25519KeyForPath derives the key from seed by following the path. SLIP-10 only defines
+// the hardened derivation for ed25519, so every index of the path is hardened.
+func deriveEd25519KeyForPath(seed []byte, path string) ([]byte, error) {
+	key, chainCode := i64([]byte("ed25519 seed"), seed)
+	if len(path) == 0 {
+		return key[:], nil
+	}
+
+	for _, part := range strings.Split(path, "/") {
+		idx, err := strconv.ParseUint(strings.TrimSuffix(part, "'"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SLIP-10 path: %s", err)
+		}
+
+		data := append([]byte{byte(0)}, key[:]...)
+		data = append(data, uint32ToBytes(uint32(idx)|0x80000000)...)
+		key, chainCode = i64(chainCode[:], data)
+	}
+	return key[:], nil
+}
@@ -3,9 +3,14 @@ package crypto_test
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
 
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
 	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
@@ -23,3 +28,53 @@ func TestNewMnemonicKeyManager(t *testing.T) {
 	address := sdk.AccAddress(pubKey.Address()).String()
 	assert.Equal(t, "iaa1y9kd9uy7a4qnjp0z5yjx5jhrkv2ycdkzqc0h8z", address)
 }
+
+func TestKeyManagerAlgos(t *testing.T) {
+	mnemonic := "nerve leader thank marriage spice task van start piece crowd run hospital control outside cousin romance left choice poet wagon rude climb leisure spring"
+	registry := codectypes.NewInterfaceRegistry()
+	cryptoamino.RegisterInterfaces(registry)
+
+	for _, algo := range []string{"secp256k1", "ed25519", "sm2"} {
+		t.Run(algo, func(t *testing.T) {
+			km, err := crypto.NewMnemonicKeyManager(mnemonic, algo)
+			require.NoError(t, err)
+
+			// the derivation is deterministic
+			km2, err := crypto.NewMnemonicKeyManager(mnemonic, algo)
+			require.NoError(t, err)
+			require.Equal(t, km.ExportPubKey(), km2.ExportPubKey())
+
+			pubKey := km.ExportPubKey()
+			require.Equal(t, algo, pubKey.Type())
+
+			data := []byte("sign bytes")
+			sig, err := km.Sign(data)
+			require.NoError(t, err)
+			require.True(t, pubKey.VerifySignature(data, sig))
+
+			// armor export and import keep the algo
+			armor, err := km.ExportPrivKey("12345678")
+			require.NoError(t, err)
+			priv, importedAlgo, err := crypto.NewKeyManager().ImportPrivKey(armor, "12345678")
+			require.NoError(t, err)
+			require.Equal(t, algo, importedAlgo)
+			require.Equal(t, pubKey, priv.PubKey())
+
+			// amino round trip, as used by the KeyDAO
+			_, privKey := km.Generate()
+			km3, err := crypto.NewPrivateKeyManager(cryptoamino.MarshalPrivKey(privKey), algo)
+			require.NoError(t, err)
+			require.Equal(t, pubKey, km3.ExportPubKey())
+			decoded, err := cryptoamino.PubKeyFromBytes(cryptoamino.MarshalPubkey(pubKey))
+			require.NoError(t, err)
+			require.Equal(t, pubKey, decoded)
+
+			// proto Any round trip, as used by the signer infos of a tx
+			any, err := codectypes.NewAnyWithValue(pubKey.(proto.Message))
+			require.NoError(t, err)
+			var unpacked tmcrypto.PubKey
+			require.NoError(t, registry.UnpackAny(any, &unpacked))
+			require.Equal(t, pubKey, unpacked)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package sm2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/keys/sm2"
+)
+
+func TestSignAndValidateSm2(t *testing.T) {
+	privKey := sm2.GenPrivKey()
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), sm2.PubKeySize)
+
+	msg := crypto.CRandBytes(128)
+	sig, err := privKey.Sign(msg)
+	require.Nil(t, err)
+	require.Len(t, sig, sm2.SignatureSize)
+
+	// Test the signature
+	assert.True(t, pubKey.VerifySignature(msg, sig))
+
+	// Mutate the signature, just one bit.
+	sig[7] ^= byte(0x01)
+	assert.False(t, pubKey.VerifySignature(msg, sig))
+
+	// Verify with another key
+	assert.False(t, sm2.GenPrivKey().PubKey().VerifySignature(msg, sig))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	secret := []byte("secret")
+	require.True(t, sm2.GenPrivKeyFromSecret(secret).Equals(sm2.GenPrivKeyFromSecret(secret)))
+	require.False(t, sm2.GenPrivKeyFromSecret(secret).Equals(sm2.GenPrivKeyFromSecret([]byte("other"))))
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey := sm2.GenPrivKey()
+	pubKey := privKey.PubKey().(*sm2.PubKey)
+
+	testCases := []struct {
+		desc string
+		msg  codec.AminoMarshaler
+		typ  interface{}
+	}{
+		{"sm2 private key", privKey, &sm2.PrivKey{}},
+		{"sm2 public key", pubKey, &sm2.PubKey{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			bz, err := aminoCdc.MarshalBinaryBare(tc.msg)
+			require.NoError(t, err)
+
+			err = aminoCdc.UnmarshalBinaryBare(bz, tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
@@ -0,0 +1,284 @@
+// Package indexer walks the blocks of the chain from a start height to the head, then follows the new
+// blocks, and writes the blocks, txs, messages and events of every block to a pluggable Sink.
+//
+//	sink, err := indexer.NewFileSink("./index")
+//	ix := indexer.NewIndexer(client.BaseClient, client.EncodingConfig(), sink, sink, indexer.Options{StartHeight: 1})
+//	err = ix.Run(ctx)
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	defaultPollInterval = 1 * time.Second
+	maxRetryInterval    = 30 * time.Second
+)
+
+type Options struct {
+	// height of the first block indexed when there is no checkpoint, default 1
+	StartHeight int64
+	// interval of the polling of the head once the indexer has caught up, default 1s
+	PollInterval time.Duration
+	Logger       log.Logger
+}
+
+type Indexer struct {
+	source     Source
+	encoding   sdk.EncodingConfig
+	sink       Sink
+	checkpoint Checkpointer
+	opts       Options
+}
+
+func NewIndexer(source Source, encoding sdk.EncodingConfig, sink Sink, checkpoint Checkpointer, opts Options) *Indexer {
+	if opts.StartHeight <= 0 {
+		opts.StartHeight = 1
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.Logger == nil {
+		opts.Logger = log.NewNopLogger()
+	}
+	return &Indexer{
+		source:     source,
+		encoding:   encoding,
+		sink:       sink,
+		checkpoint: checkpoint,
+		opts:       opts,
+	}
+}
+
+// Run indexes the blocks from the block after the checkpoint until ctx is done. The errors of
+// the node are retried with a backoff, the errors of the sink and the checkpointer stop the run.
+func (ix *Indexer) Run(ctx context.Context) error {
+	last, err := ix.checkpoint.LoadCheckpoint(ctx)
+	if err != nil {
+		return fmt.Errorf("load checkpoint: %w", err)
+	}
+	next := ix.opts.StartHeight
+	if last > 0 {
+		next = last + 1
+	}
+	ix.opts.Logger.Info("start indexing", "height", next)
+
+	retry := ix.opts.PollInterval
+	for {
+		wait := ix.opts.PollInterval
+		err := ix.catchUp(ctx, &next)
+		switch {
+		case err == nil:
+			retry = ix.opts.PollInterval
+		case ctx.Err() != nil:
+			return ctx.Err()
+		case isSinkError(err):
+			return err
+		default:
+			ix.opts.Logger.Error("index block failed", "height", next, "retry", retry.String(), "errMsg", err.Error())
+			wait = retry
+			if retry *= 2; retry > maxRetryInterval {
+				retry = maxRetryInterval
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// catchUp indexes the blocks up to the latest height of the node
+func (ix *Indexer) catchUp(ctx context.Context, next *int64) error {
+	status, err := ix.source.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	head := status.SyncInfo.LatestBlockHeight
+	for ; *next <= head; *next++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		records, err := ix.IndexBlock(ctx, *next)
+		if err != nil {
+			return err
+		}
+		if err := ix.sink.Write(ctx, records); err != nil {
+			return sinkError{fmt.Errorf("write block %d: %w", *next, err)}
+		}
+		if err := ix.checkpoint.SaveCheckpoint(ctx, *next); err != nil {
+			return sinkError{fmt.Errorf("save checkpoint %d: %w", *next, err)}
+		}
+	}
+	return nil
+}
+
+// IndexBlock returns the records of the block at the height
+func (ix *Indexer) IndexBlock(ctx context.Context, height int64) (Records, error) {
+	block, err := ix.source.Block(ctx, &height)
+	if err != nil {
+		return Records{}, err
+	}
+	results, err := ix.source.BlockResults(ctx, &height)
+	if err != nil {
+		return Records{}, err
+	}
+	if len(results.TxsResults) != len(block.Block.Txs) {
+		return Records{}, fmt.Errorf("block %d has %d txs but %d results", height, len(block.Block.Txs), len(results.TxsResults))
+	}
+
+	header := block.Block.Header
+	records := Records{
+		Block: Block{
+			Height:          height,
+			Hash:            block.BlockID.Hash.String(),
+			Time:            header.Time,
+			ProposerAddress: header.ProposerAddress.String(),
+			NumTxs:          len(block.Block.Txs),
+		},
+	}
+	records.Events = append(records.Events, blockEvents(height, BeginBlock, results.BeginBlockEvents)...)
+
+	for i, txBytes := range block.Block.Txs {
+		result := results.TxsResults[i]
+		tx := Tx{
+			Height:    height,
+			Index:     i,
+			Hash:      sdk.HexBytes(tmhash.Sum(txBytes)).String(),
+			Time:      header.Time,
+			Code:      result.Code,
+			Codespace: result.Codespace,
+			Log:       result.Log,
+			GasWanted: result.GasWanted,
+			GasUsed:   result.GasUsed,
+		}
+
+		decoded, err := ix.encoding.TxConfig.TxDecoder()(txBytes)
+		if err != nil {
+			tx.DecodeError = err.Error()
+		} else {
+			tx.Tx = decoded
+			if memoTx, ok := decoded.(sdk.TxWithMemo); ok {
+				tx.Memo = memoTx.GetMemo()
+			}
+			if feeTx, ok := decoded.(sdk.FeeTx); ok {
+				tx.Fee = feeTx.GetFee()
+			}
+
+			records.Messages = append(records.Messages, ix.messages(tx, decoded.GetMsgs())...)
+		}
+
+		records.Events = append(records.Events, txEvents(tx, result)...)
+		if tx.Code != 0 || len(tx.DecodeError) > 0 {
+			records.FailedTxs = append(records.FailedTxs, tx)
+		} else {
+			records.Txs = append(records.Txs, tx)
+		}
+	}
+
+	records.Events = append(records.Events, blockEvents(height, EndBlock, results.EndBlockEvents)...)
+	return records, nil
+}
+
+// messages returns the records of the msgs of the tx, a msg which can not be encoded
+// is recorded with its error, as indexing the block again would not help
+func (ix *Indexer) messages(tx Tx, msgs []sdk.Msg) []Message {
+	records := make([]Message, len(msgs))
+	for i, msg := range msgs {
+		records[i] = Message{
+			Height:  tx.Height,
+			TxHash:  tx.Hash,
+			TxIndex: tx.Index,
+			Index:   i,
+			Type:    "/" + proto.MessageName(msg),
+			Msg:     msg,
+		}
+
+		value, err := ix.encoding.Marshaler.MarshalJSON(msg)
+		if err != nil {
+			ix.opts.Logger.Error("marshal message failed", "height", tx.Height, "hash", tx.Hash, "index", i, "errMsg", err.Error())
+			records[i].MarshalError = err.Error()
+			continue
+		}
+		records[i].Value = value
+	}
+	return records
+}
+
+// txEvents returns the events of each message from the log of a successful tx, or the events
+// of the tx as a whole if the log does not have them. The events are not merged by type.
+func txEvents(tx Tx, result *abci.ResponseDeliverTx) []Event {
+	var events []Event
+	if result.Code == 0 {
+		if logs, err := sdk.ParseABCILogs(result.Log); err == nil && len(logs) > 0 {
+			for _, log := range logs {
+				for _, e := range log.Events {
+					events = append(events, Event{
+						Height:     tx.Height,
+						Stage:      DeliverTx,
+						TxHash:     tx.Hash,
+						MsgIndex:   int(log.MsgIndex),
+						Type:       e.Type,
+						Attributes: e.Attributes,
+					})
+				}
+			}
+			return events
+		}
+	}
+
+	for _, abciEvent := range result.Events {
+		e := sdk.StringifyEvent(abciEvent)
+		events = append(events, Event{
+			Height:     tx.Height,
+			Stage:      DeliverTx,
+			TxHash:     tx.Hash,
+			MsgIndex:   -1,
+			Type:       e.Type,
+			Attributes: e.Attributes,
+		})
+	}
+	return events
+}
+
+func blockEvents(height int64, stage Stage, abciEvents []abci.Event) []Event {
+	var events []Event
+	for _, abciEvent := range abciEvents {
+		e := sdk.StringifyEvent(abciEvent)
+		events = append(events, Event{
+			Height:     height,
+			Stage:      stage,
+			MsgIndex:   -1,
+			Type:       e.Type,
+			Attributes: e.Attributes,
+		})
+	}
+	return events
+}
+
+// sinkError is an error of the sink or the checkpointer, which is not retried
+type sinkError struct {
+	error
+}
+
+func (e sinkError) Unwrap() error {
+	return e.error
+}
+
+func isSinkError(err error) bool {
+	_, ok := err.(sinkError)
+	return ok
+}
@@ -0,0 +1,257 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/feegrant"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	txtypes "github.com/irisnet/irishub-sdk-go/types/tx"
+)
+
+// fakeSource serves the blocks up to its head
+type fakeSource struct {
+	mu      sync.Mutex
+	head    int64
+	txs     map[int64][]tmtypes.Tx
+	results map[int64][]*abci.ResponseDeliverTx
+	fail    bool
+}
+
+func (s *fakeSource) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return nil, errors.New("node unavailable")
+	}
+	block := &tmtypes.Block{}
+	block.Height = *height
+	block.Time = time.Unix(*height, 0).UTC()
+	block.Txs = s.txs[*height]
+	return &ctypes.ResultBlock{Block: block}, nil
+}
+
+func (s *fakeSource) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &ctypes.ResultBlockResults{
+		Height:     *height,
+		TxsResults: s.results[*height],
+		BeginBlockEvents: []abci.Event{
+			{Type: "transfer", Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("1uiris")}}},
+			{Type: "transfer", Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("2uiris")}}},
+		},
+	}, nil
+}
+
+func (s *fakeSource) Status(_ context.Context) (*ctypes.ResultStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return nil, errors.New("node unavailable")
+	}
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: s.head}}, nil
+}
+
+func makeEncodingConfig() sdk.EncodingConfig {
+	registry := codectypes.NewInterfaceRegistry()
+	registry.RegisterInterface("cosmos.v1beta1.Msg", (*sdk.Msg)(nil))
+	cryptocodec.RegisterInterfaces(registry)
+	txtypes.RegisterInterfaces(registry)
+	bank.RegisterInterfaces(registry)
+	marshaler := codec.NewProtoCodec(registry)
+	return sdk.EncodingConfig{
+		InterfaceRegistry: registry,
+		Marshaler:         marshaler,
+		TxConfig:          txtypes.NewTxConfig(marshaler, txtypes.DefaultSignModes),
+	}
+}
+
+func newSource(t *testing.T, encoding sdk.EncodingConfig) *fakeSource {
+	from := sdk.AccAddress([]byte("from________________"))
+	to := sdk.AccAddress([]byte("to__________________"))
+
+	builder := encoding.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(
+		bank.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("uiris", 1))),
+		bank.NewMsgSend(to, from, sdk.NewCoins(sdk.NewInt64Coin("uiris", 2))),
+	))
+	builder.SetMemo("indexer")
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uiris", 4000)))
+	txBytes, err := encoding.TxConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	logs := sdk.ABCIMessageLogs{
+		sdk.NewABCIMessageLog(0, "", sdk.Events{sdk.NewEvent("message", sdk.NewAttribute("action", "send"))}),
+		sdk.NewABCIMessageLog(1, "", sdk.Events{sdk.NewEvent("message", sdk.NewAttribute("action", "send"))}),
+	}
+	return &fakeSource{
+		head: 3,
+		txs: map[int64][]tmtypes.Tx{
+			2: {txBytes, []byte("not a tx")},
+			3: {txBytes},
+		},
+		results: map[int64][]*abci.ResponseDeliverTx{
+			2: {{Log: logs.String()}, {Code: 2, Codespace: "sdk", Log: "tx parse error"}},
+			3: {{Code: 5, Codespace: "sdk", Log: "insufficient funds",
+				Events: []abci.Event{{Type: "tx", Attributes: []abci.EventAttribute{{Key: []byte("fee"), Value: []byte("4000uiris")}}}}}},
+		},
+	}
+}
+
+func TestIndexBlock(t *testing.T) {
+	encoding := makeEncodingConfig()
+	ix := NewIndexer(newSource(t, encoding), encoding, NewMemorySink(), NewMemorySink(), Options{})
+
+	records, err := ix.IndexBlock(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), records.Block.Height)
+	require.Equal(t, 2, records.Block.NumTxs)
+
+	require.Len(t, records.Txs, 1)
+	tx := records.Txs[0]
+	require.Equal(t, "indexer", tx.Memo)
+	require.Equal(t, "4000uiris", tx.Fee.String())
+	require.NotNil(t, tx.Tx)
+
+	require.Len(t, records.FailedTxs, 1)
+	require.Equal(t, uint32(2), records.FailedTxs[0].Code)
+	require.NotEmpty(t, records.FailedTxs[0].DecodeError)
+
+	require.Len(t, records.Messages, 2)
+	for i, msg := range records.Messages {
+		require.Equal(t, tx.Hash, msg.TxHash)
+		require.Equal(t, i, msg.Index)
+		require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", msg.Type)
+		require.IsType(t, &bank.MsgSend{}, msg.Msg)
+	}
+	var value map[string]interface{}
+	require.NoError(t, json.Unmarshal(records.Messages[1].Value, &value))
+	require.Equal(t, records.Messages[1].Msg.(*bank.MsgSend).FromAddress, value["from_address"])
+
+	// the begin block events are not merged by type, the events of the successful tx come from its log
+	require.Len(t, records.Events, 4)
+	require.Equal(t, BeginBlock, records.Events[0].Stage)
+	require.Equal(t, "1uiris", records.Events[0].Attributes[0].Value)
+	require.Equal(t, "2uiris", records.Events[1].Attributes[0].Value)
+	require.Equal(t, DeliverTx, records.Events[3].Stage)
+	require.Equal(t, 1, records.Events[3].MsgIndex)
+
+	// the events of a failed tx belong to the tx as a whole
+	records, err = ix.IndexBlock(context.Background(), 3)
+	require.NoError(t, err)
+	require.Empty(t, records.Txs)
+	require.Len(t, records.FailedTxs, 1)
+	require.Empty(t, records.FailedTxs[0].DecodeError)
+	require.Len(t, records.Events, 3)
+	require.Equal(t, -1, records.Events[2].MsgIndex)
+	require.Equal(t, "4000uiris", records.Events[2].Attributes[0].Value)
+}
+
+func TestIndexMessages(t *testing.T) {
+	encoding := makeEncodingConfig()
+	ix := NewIndexer(newSource(t, encoding), encoding, NewMemorySink(), NewMemorySink(), Options{})
+
+	// a msg which can not be encoded is recorded with its error
+	msgs := ix.messages(Tx{Height: 2, Hash: "hash"}, []sdk.Msg{
+		&feegrant.MsgGrantAllowance{Allowance: &codectypes.Any{TypeUrl: "/unknown.Allowance"}},
+		&bank.MsgSend{},
+	})
+	require.Len(t, msgs, 2)
+	require.Equal(t, "/cosmos.feegrant.v1beta1.MsgGrantAllowance", msgs[0].Type)
+	require.NotEmpty(t, msgs[0].MarshalError)
+	require.Empty(t, msgs[0].Value)
+	require.Empty(t, msgs[1].MarshalError)
+	require.NotEmpty(t, msgs[1].Value)
+}
+
+func TestIndexerRun(t *testing.T) {
+	encoding := makeEncodingConfig()
+	source := newSource(t, encoding)
+	source.fail = true
+	sink := NewMemorySink()
+	require.NoError(t, sink.SaveCheckpoint(context.Background(), 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- NewIndexer(source, encoding, sink, sink, Options{PollInterval: 10 * time.Millisecond}).Run(ctx)
+	}()
+
+	// the errors of the node are retried, then the indexer resumes after the checkpoint and follows the head
+	time.Sleep(30 * time.Millisecond)
+	source.mu.Lock()
+	source.fail = false
+	source.head = 5
+	source.mu.Unlock()
+
+	require.Eventually(t, func() bool {
+		checkpoint, _ := sink.LoadCheckpoint(ctx)
+		return checkpoint == 5
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	require.Equal(t, context.Canceled, <-done)
+
+	records := sink.Records()
+	require.Len(t, records, 4)
+	for i, r := range records {
+		require.Equal(t, int64(i+2), r.Block.Height)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	encoding := makeEncodingConfig()
+	dir := t.TempDir()
+	sink, err := NewFileSink(dir)
+	require.NoError(t, err)
+
+	checkpoint, err := sink.LoadCheckpoint(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, checkpoint)
+
+	ix := NewIndexer(newSource(t, encoding), encoding, sink, sink, Options{})
+	for height := int64(1); height <= 3; height++ {
+		records, err := ix.IndexBlock(context.Background(), height)
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(context.Background(), records))
+		require.NoError(t, sink.SaveCheckpoint(context.Background(), height))
+	}
+	require.NoError(t, sink.Close())
+
+	// the files are appended to after a restart
+	sink, err = NewFileSink(dir)
+	require.NoError(t, err)
+	defer sink.Close()
+	checkpoint, err = sink.LoadCheckpoint(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(3), checkpoint)
+
+	count := func(name string) int {
+		var n int
+		require.NoError(t, ReadJSONLines(filepath.Join(dir, name), func(line []byte) error {
+			n++
+			return json.Unmarshal(line, &map[string]interface{}{})
+		}))
+		return n
+	}
+	require.Equal(t, 3, count(blocksFile))
+	require.Equal(t, 1, count(txsFile))
+	require.Equal(t, 2, count(failedTxsFile))
+	require.Equal(t, 4, count(messagesFile))
+	require.Equal(t, 9, count(eventsFile))
+}
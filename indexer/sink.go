@@ -0,0 +1,195 @@
+package indexer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	blocksFile     = "blocks.jsonl"
+	txsFile        = "txs.jsonl"
+	failedTxsFile  = "failed_txs.jsonl"
+	messagesFile   = "messages.jsonl"
+	eventsFile     = "events.jsonl"
+	checkpointFile = "checkpoint"
+)
+
+var (
+	_ Sink         = &FileSink{}
+	_ Checkpointer = &FileSink{}
+	_ Sink         = &MemorySink{}
+	_ Checkpointer = &MemorySink{}
+)
+
+// FileSink appends the records as JSON lines to a file per record type in a directory,
+// and stores the checkpoint in the same directory
+type FileSink struct {
+	dir   string
+	mu    sync.Mutex
+	files map[string]*os.File
+}
+
+// NewFileSink opens or creates the files of the sink in dir
+func NewFileSink(dir string) (*FileSink, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	s := &FileSink{dir: dir, files: make(map[string]*os.File)}
+	for _, name := range []string{blocksFile, txsFile, failedTxsFile, messagesFile, eventsFile} {
+		f, err := os.OpenFile(filepath.Join(dir, name), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+		s.files[name] = f
+	}
+	return s, nil
+}
+
+func (s *FileSink) Write(_ context.Context, records Records) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.append(blocksFile, records.Block); err != nil {
+		return err
+	}
+	for _, tx := range records.Txs {
+		if err := s.append(txsFile, tx); err != nil {
+			return err
+		}
+	}
+	for _, tx := range records.FailedTxs {
+		if err := s.append(failedTxsFile, tx); err != nil {
+			return err
+		}
+	}
+	for _, msg := range records.Messages {
+		if err := s.append(messagesFile, msg); err != nil {
+			return err
+		}
+	}
+	for _, event := range records.Events {
+		if err := s.append(eventsFile, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *FileSink) append(name string, record interface{}) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	_, err = s.files[name].Write(append(bz, '\n'))
+	return err
+}
+
+func (s *FileSink) LoadCheckpoint(_ context.Context) (int64, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(s.dir, checkpointFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+}
+
+// SaveCheckpoint syncs the record files, then replaces the checkpoint file
+func (s *FileSink) SaveCheckpoint(_ context.Context, height int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, f := range s.files {
+		if err := f.Sync(); err != nil {
+			return err
+		}
+	}
+
+	path := filepath.Join(s.dir, checkpointFile)
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.FormatInt(height, 10)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Close closes the record files
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var err error
+	for name, f := range s.files {
+		if e := f.Close(); e != nil && err == nil {
+			err = e
+		}
+		delete(s.files, name)
+	}
+	return err
+}
+
+// ReadJSONLines decodes every line of a file written by FileSink with the handler
+func ReadJSONLines(path string, handler func(line []byte) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		if err := handler(scanner.Bytes()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// MemorySink keeps the records and the checkpoint in memory
+type MemorySink struct {
+	mu         sync.Mutex
+	records    []Records
+	checkpoint int64
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (s *MemorySink) Write(_ context.Context, records Records) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, records)
+	return nil
+}
+
+// Records returns the records written to the sink
+func (s *MemorySink) Records() []Records {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Records(nil), s.records...)
+}
+
+func (s *MemorySink) LoadCheckpoint(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoint, nil
+}
+
+func (s *MemorySink) SaveCheckpoint(_ context.Context, height int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoint = height
+	return nil
+}
@@ -0,0 +1,100 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// Source is the node the blocks are read from, it is implemented by sdk.BaseClient
+type Source interface {
+	Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error)
+	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+}
+
+// Sink receives the records of every indexed block, in the order of the heights
+type Sink interface {
+	Write(ctx context.Context, records Records) error
+}
+
+// Checkpointer stores the height of the last block written to the sink, so that the
+// indexer resumes from the next block after a restart. The records of a block may be
+// written twice if the indexer stops between Sink.Write and SaveCheckpoint.
+type Checkpointer interface {
+	// LoadCheckpoint returns the last indexed height, 0 if no block has been indexed
+	LoadCheckpoint(ctx context.Context) (int64, error)
+	SaveCheckpoint(ctx context.Context, height int64) error
+}
+
+// Stage is the step of the block execution an event has been emitted in
+type Stage string
+
+const (
+	BeginBlock Stage = "begin_block"
+	DeliverTx  Stage = "deliver_tx"
+	EndBlock   Stage = "end_block"
+)
+
+// Records are the records extracted from a block
+type Records struct {
+	Block Block `json:"block"`
+	// the txs executed successfully
+	Txs []Tx `json:"txs"`
+	// the txs failed in DeliverTx or that could not be decoded
+	FailedTxs []Tx      `json:"failed_txs"`
+	Messages  []Message `json:"messages"`
+	Events    []Event   `json:"events"`
+}
+
+type Block struct {
+	Height          int64     `json:"height"`
+	Hash            string    `json:"hash"`
+	Time            time.Time `json:"time"`
+	ProposerAddress string    `json:"proposer_address"`
+	NumTxs          int       `json:"num_txs"`
+}
+
+type Tx struct {
+	Height      int64     `json:"height"`
+	Index       int       `json:"index"`
+	Hash        string    `json:"hash"`
+	Time        time.Time `json:"time"`
+	Code        uint32    `json:"code"`
+	Codespace   string    `json:"codespace,omitempty"`
+	Log         string    `json:"log"`
+	GasWanted   int64     `json:"gas_wanted"`
+	GasUsed     int64     `json:"gas_used"`
+	Memo        string    `json:"memo,omitempty"`
+	Fee         sdk.Coins `json:"fee,omitempty"`
+	DecodeError string    `json:"decode_error,omitempty"`
+	// the decoded tx, nil if it could not be decoded
+	Tx sdk.Tx `json:"-"`
+}
+
+type Message struct {
+	Height  int64  `json:"height"`
+	TxHash  string `json:"tx_hash"`
+	TxIndex int    `json:"tx_index"`
+	Index   int    `json:"index"`
+	// type url of the message, for example /cosmos.bank.v1beta1.MsgSend
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+	// the error of the json encoding of the message, whose Value is then empty
+	MarshalError string  `json:"marshal_error,omitempty"`
+	Msg          sdk.Msg `json:"-"`
+}
+
+type Event struct {
+	Height int64  `json:"height"`
+	Stage  Stage  `json:"stage"`
+	TxHash string `json:"tx_hash,omitempty"`
+	// index of the message emitting the event, -1 for the events of a block or of a tx as a whole
+	MsgIndex   int             `json:"msg_index"`
+	Type       string          `json:"type"`
+	Attributes []sdk.Attribute `json:"attributes"`
+}
@@ -0,0 +1,56 @@
+package integration_test
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go"
+	"github.com/irisnet/irishub-sdk-go/modules/authz"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/types"
+)
+
+func (s IntegrationTestSuite) TestAuthz() {
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Mode:     types.Commit,
+		Password: s.Account().Password,
+	}
+	grantee := s.GetRandAccount().Address.String()
+
+	spendLimit, err := s.ToMinCoin(types.NewDecCoin("iris", types.NewInt(10)))
+	require.NoError(s.T(), err)
+	res, err := s.Authz.Grant(grantee, bank.NewSendAuthorization(spendLimit), time.Now().Add(time.Hour), baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	grants, err := s.Authz.QueryGrants(authz.QueryGrantsReq{
+		Granter: s.Account().Address.String(),
+		Grantee: grantee,
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), grants.Grants, 1)
+	msgTypeURL := types.MsgTypeURL(&bank.MsgSend{})
+	require.Equal(s.T(), msgTypeURL, grants.Grants[0].MsgTypeURL)
+	require.Equal(s.T(), spendLimit, grants.Grants[0].Authorization.(*bank.SendAuthorization).SpendLimit)
+
+	// the msgs of the module calls are collected instead of being broadcast
+	msgs, e := s.CollectMsgs(func(c sdk.IRISHUBClient) error {
+		_, err := c.Bank.Send(grantee, types.NewDecCoins(types.NewDecCoin("iris", types.NewInt(1))), types.BaseTx{
+			From: s.Account().Address.String(),
+		})
+		return err
+	}, s.Account().Address.String())
+	require.NoError(s.T(), e)
+	require.Len(s.T(), msgs, 1)
+	require.Equal(s.T(), s.Account().Address.String(), msgs[0].(*bank.MsgSend).FromAddress)
+	exec, e := authz.NewMsgExec(s.GetRandAccount().Address, msgs)
+	require.NoError(s.T(), e)
+	require.NoError(s.T(), exec.ValidateBasic())
+
+	res, err = s.Authz.Revoke(grantee, msgTypeURL, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+}
@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
@@ -35,6 +36,10 @@ func (s IntegrationTestSuite) TestBank() {
 			"TestSendWitchSpecAccountInfo",
 			sendWitchSpecAccountInfo,
 		},
+		{
+			"TestWaitForTx",
+			waitForTx,
+		},
 	}
 
 	for _, t := range cases {
@@ -183,3 +188,29 @@ func sendWitchSpecAccountInfo(s IntegrationTestSuite) {
 		require.NotEmpty(s.T(), res.Hash)
 	}
 }
+
+func waitForTx(s IntegrationTestSuite) {
+	coins, err := types.ParseDecCoins("10iris")
+	s.NoError(err)
+	to := s.GetRandAccount().Address.String()
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "TEST",
+		Mode:     types.Sync,
+		Password: s.Account().Password,
+	}
+
+	res, err := s.Bank.Send(to, coins, baseTx)
+	s.NoError(err)
+	s.NotEmpty(res.Hash)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	tx, err := s.Manager().WaitForTx(ctx, res, types.ConfirmOptions{})
+	s.NoError(err)
+	s.Equal(res.Hash, tx.Hash)
+	s.Equal(uint32(0), tx.Result.Code)
+	s.Greater(tx.Height, int64(0))
+}
@@ -0,0 +1,83 @@
+package integration_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func (s IntegrationTestSuite) TestDistribution() {
+	baseTx := sdk.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Memo:     "test",
+		Mode:     sdk.Commit,
+		Password: s.Account().Password,
+	}
+	delegatorAddr := s.Account().Address.String()
+
+	validators, err := s.Staking.QueryValidators("", 1, 10)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), validators.Validators)
+	validatorAddr := validators.Validators[0].OperatorAddress
+
+	amount, _ := sdk.ParseDecCoin("10000iris")
+	res, err := s.Staking.Delegate(staking.DelegateRequest{
+		ValidatorAddr: validatorAddr,
+		Amount:        amount,
+	}, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	validatorAddrs, err := s.Distribution.QueryDelegatorValidators(delegatorAddr)
+	require.NoError(s.T(), err)
+	require.Contains(s.T(), validatorAddrs, validatorAddr)
+
+	_, err = s.Distribution.QueryDelegationRewards(delegatorAddr, validatorAddr)
+	require.NoError(s.T(), err)
+
+	totalRewards, err := s.Distribution.QueryDelegationTotalRewards(delegatorAddr)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), totalRewards.Rewards, len(validatorAddrs))
+
+	_, err = s.Distribution.QueryValidatorOutstandingRewards(validatorAddr)
+	require.NoError(s.T(), err)
+
+	_, err = s.Distribution.QueryValidatorCommission(validatorAddr)
+	require.NoError(s.T(), err)
+
+	_, err = s.Distribution.QueryValidatorSlashes(distribution.QueryValidatorSlashesReq{ValidatorAddr: validatorAddr})
+	require.NoError(s.T(), err)
+
+	params, err := s.Distribution.QueryParams()
+	require.NoError(s.T(), err)
+	require.True(s.T(), params.CommunityTax.IsPositive())
+
+	res, err = s.Distribution.WithdrawDelegatorReward(validatorAddr, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	res, err = s.Distribution.WithdrawAllDelegatorRewards(baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	withdrawAddr := s.GetRandAccount().Address.String()
+	res, err = s.Distribution.SetWithdrawAddress(withdrawAddr, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	addr, err := s.Distribution.QueryWithdrawAddress(delegatorAddr)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), withdrawAddr, addr)
+
+	fund, _ := sdk.ParseDecCoins("1iris")
+	res, err = s.Distribution.FundCommunityPool(fund, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	pool, err := s.Distribution.QueryCommunityPool()
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), pool)
+}
@@ -0,0 +1,65 @@
+package integration_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/modules/feegrant"
+	"github.com/irisnet/irishub-sdk-go/types"
+)
+
+func (s IntegrationTestSuite) TestFeegrant() {
+	baseTx := types.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Mode:     types.Commit,
+		Password: s.Account().Password,
+	}
+	grantee := s.GetRandAccount()
+	amount := types.NewDecCoins(types.NewDecCoin("iris", types.NewInt(1)))
+
+	// the grantee needs an account on chain to sign, and funds for its two sends
+	res, err := s.Bank.Send(grantee.Address.String(), types.NewDecCoins(types.NewDecCoin("iris", types.NewInt(2))), baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	spendLimit, err := s.ToMinCoin(types.NewDecCoin("iris", types.NewInt(10)))
+	require.NoError(s.T(), err)
+	res, err = s.Feegrant.GrantAllowance(grantee.Address.String(), feegrant.NewBasicAllowance(spendLimit, nil), baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	allowance, err := s.Feegrant.QueryAllowance(s.Account().Address.String(), grantee.Address.String())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), spendLimit, allowance.Allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	allowances, err := s.Feegrant.QueryAllowances(grantee.Address.String(), 1, 10)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), allowances.Allowances)
+
+	// the fee of the grantee is paid by the allowance of the granter
+	res, err = s.Bank.Send(s.Account().Address.String(), amount, types.BaseTx{
+		From:       grantee.Name,
+		Password:   grantee.Password,
+		Gas:        200000,
+		Mode:       types.Commit,
+		FeeGranter: s.Account().Address.String(),
+	})
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	res, err = s.Feegrant.RevokeAllowance(grantee.Address.String(), baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+
+	// the fee payer co-signs the tx
+	res, err = s.Bank.Send(s.Account().Address.String(), amount, types.BaseTx{
+		From:             grantee.Name,
+		Password:         grantee.Password,
+		Gas:              200000,
+		Mode:             types.Commit,
+		FeePayer:         s.Account().Name,
+		FeePayerPassword: s.Account().Password,
+	})
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+}
@@ -0,0 +1,67 @@
+package integration_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/transfer"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func (s IntegrationTestSuite) TestIBCTransfer() {
+	trace := transfer.ParseDenomTrace("transfer/channel-0/uatom")
+	require.Equal(s.T(), "transfer/channel-0", trace.Path)
+	require.Equal(s.T(), "uatom", trace.BaseDenom)
+	denom := trace.IBCDenom()
+	require.Equal(s.T(), "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", denom)
+	hash, err := transfer.ParseIBCDenom(denom)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), trace.Hash(), hash)
+	require.True(s.T(), transfer.IsDenomPath(trace.GetFullDenomPath()))
+	require.False(s.T(), transfer.IsDenomPath("transfer/uatom"))
+
+	params, err := s.Transfer.QueryParams()
+	require.NoError(s.T(), err)
+	require.True(s.T(), params.SendEnabled)
+
+	traces, err := s.Transfer.QueryDenomTraces(1, 10)
+	require.NoError(s.T(), err)
+	for _, trace := range traces.DenomTraces {
+		coins, err := s.ToMainCoin(sdk.NewCoin(trace.IBCDenom(), sdk.NewInt(1)))
+		require.NoError(s.T(), err)
+		require.Contains(s.T(), coins[0].Denom, trace.Path)
+	}
+
+	channels, err := s.Transfer.QueryChannels(1, 10)
+	require.NoError(s.T(), err)
+	baseTx := sdk.BaseTx{
+		From:     s.Account().Name,
+		Gas:      200000,
+		Mode:     sdk.Commit,
+		Password: s.Account().Password,
+	}
+	amount := sdk.NewDecCoin("iris", sdk.NewInt(1))
+	if len(channels.Channels) == 0 {
+		// the client state of the channel is queried for the default timeouts
+		_, err = s.Transfer.Transfer(transfer.TransferRequest{
+			SourceChannel: "channel-0",
+			Receiver:      s.GetRandAccount().Address.String(),
+			Amount:        amount,
+		}, baseTx)
+		require.Error(s.T(), err)
+		return
+	}
+
+	channel := channels.Channels[0]
+	clientState, err := s.Transfer.QueryChannelClientState(channel.PortID, channel.ChannelID)
+	require.NoError(s.T(), err)
+	require.False(s.T(), clientState.LatestHeight.IsZero())
+
+	res, err := s.Transfer.Transfer(transfer.TransferRequest{
+		SourcePort:    channel.PortID,
+		SourceChannel: channel.ChannelID,
+		Receiver:      s.GetRandAccount().Address.String(),
+		Amount:        amount,
+	}, baseTx)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), res.Hash)
+}
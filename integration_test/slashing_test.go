@@ -0,0 +1,42 @@
+package integration_test
+
+import (
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func (s IntegrationTestSuite) TestSlashing() {
+	validators, err := s.Staking.QueryValidators("", 1, 10)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), validators.Validators)
+	validator := validators.Validators[0]
+	require.NotEmpty(s.T(), validator.ConsensusAddress)
+
+	info, err := s.Slashing.QuerySigningInfo(validator.ConsensusAddress)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), validator.ConsensusAddress, info.Address)
+
+	validatorInfo, err := s.Slashing.QueryValidatorSigningInfo(validator.OperatorAddress)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), validator.OperatorAddress, validatorInfo.Validator.OperatorAddress)
+	require.Equal(s.T(), validator.Jailed, validatorInfo.Validator.Jailed)
+	require.Equal(s.T(), info.Address, validatorInfo.SigningInfo.Address)
+
+	infos, err := s.Slashing.QuerySigningInfos(1, 10)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), infos.SigningInfos)
+
+	var count uint64
+	err = s.Slashing.IterateSigningInfos(sdk.PageOptions{PageSize: 1}, func(info slashing.QuerySigningInfoResp) bool {
+		count++
+		return true
+	})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), infos.Total, count)
+
+	params, err := s.Slashing.QueryParams()
+	require.NoError(s.T(), err)
+	require.Greater(s.T(), params.SignedBlocksWindow, int64(0))
+}
@@ -20,8 +20,9 @@ type accountQuery struct {
 	sdk.GRPCClient
 	log.Logger
 	cache.Cache
+	ctx        context.Context
 	cdc        codec.Marshaler
-	km         sdk.KeyManager
+	signer     sdk.Signer
 	expiration time.Duration
 }
 
@@ -44,8 +45,11 @@ func (a accountQuery) QueryAndRefreshAccount(address string) (sdk.BaseAccount, s
 }
 
 func (a accountQuery) QueryAccount(address string) (sdk.BaseAccount, sdk.Error) {
+	return a.queryAccount(a.ctx, address)
+}
+
+func (a accountQuery) queryAccount(ctx context.Context, address string) (sdk.BaseAccount, sdk.Error) {
 	conn, err := a.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return sdk.BaseAccount{}, sdk.Wrap(err)
 	}
@@ -54,7 +58,7 @@ func (a accountQuery) QueryAccount(address string) (sdk.BaseAccount, sdk.Error)
 		Address: address,
 	}
 
-	response, err := auth.NewQueryClient(conn).Account(context.Background(), request)
+	response, err := auth.NewQueryClient(conn).Account(ctx, request)
 	if err != nil {
 		return sdk.BaseAccount{}, sdk.Wrap(err)
 	}
@@ -70,7 +74,7 @@ func (a accountQuery) QueryAccount(address string) (sdk.BaseAccount, sdk.Error)
 		Address:    address,
 		Pagination: nil,
 	}
-	balances, err := bank.NewQueryClient(conn).AllBalances(context.Background(), breq)
+	balances, err := bank.NewQueryClient(conn).AllBalances(ctx, breq)
 	if err != nil {
 		return sdk.BaseAccount{}, sdk.Wrap(err)
 	}
@@ -91,7 +95,7 @@ func (a accountQuery) QueryAddress(name, password string) (sdk.AccAddress, sdk.E
 		}
 	}
 
-	_, address, err := a.km.Find(name, password)
+	_, address, err := a.signer.Find(name, password)
 	if err != nil {
 		a.Debug("can't find account", "name", name)
 		return address, sdk.Wrap(err)
@@ -0,0 +1,130 @@
+package authz
+
+import (
+	"context"
+	"time"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type authzClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &authzClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (ac authzClient) Name() string {
+	return ModuleName
+}
+
+func (ac authzClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (ac authzClient) WithContext(ctx context.Context) Client {
+	return NewClient(ac.BaseClient.WithContext(ctx), ac.Marshaler)
+}
+
+// Grant grants the grantee the execution of the msgs of the authorization on behalf of the sender
+// until the expiration
+func (ac authzClient) Grant(grantee string, authorization Authorization, expiration time.Time, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	granter, err := ac.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	grant, e := NewGrant(authorization, expiration)
+	if e != nil {
+		return sdk.ResultTx{}, sdk.Wrap(e)
+	}
+
+	msg := &MsgGrant{
+		Granter: granter.String(),
+		Grantee: grantee,
+		Grant:   grant,
+	}
+	return ac.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// Revoke revokes the grant of the msg type url of the sender to the grantee
+func (ac authzClient) Revoke(grantee, msgTypeURL string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	granter, err := ac.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgRevoke{
+		Granter:    granter.String(),
+		Grantee:    grantee,
+		MsgTypeUrl: msgTypeURL,
+	}
+	return ac.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// Exec executes the msgs on behalf of their granters, the sender being the grantee. The msgs
+// of any module call can be collected with a MsgCollector, see IRISHUBClient.CollectMsgs.
+func (ac authzClient) Exec(msgs []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	grantee, err := ac.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg, e := NewMsgExec(grantee, msgs)
+	if e != nil {
+		return sdk.ResultTx{}, sdk.Wrap(e)
+	}
+	return ac.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (ac authzClient) QueryGrants(request QueryGrantsReq) (QueryGrantsResp, sdk.Error) {
+	conn, err := ac.GenConn()
+	if err != nil {
+		return QueryGrantsResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(request.Page, request.Size)
+	res, err := NewQueryClient(conn).Grants(
+		ac.Context(),
+		&QueryGrantsRequest{
+			Granter:    request.Granter,
+			Grantee:    request.Grantee,
+			MsgTypeUrl: request.MsgTypeURL,
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryGrantsResp{}, sdk.Wrap(err)
+	}
+
+	grants := make([]QueryGrantResp, len(res.Grants))
+	for i, grant := range res.Grants {
+		var authorization Authorization
+		if err := ac.UnpackAny(grant.Authorization, &authorization); err != nil {
+			return QueryGrantsResp{}, sdk.Wrap(err)
+		}
+		grants[i] = QueryGrantResp{
+			MsgTypeURL:    authorization.MsgTypeURL(),
+			Authorization: authorization,
+			Expiration:    grant.Expiration,
+		}
+	}
+	return QueryGrantsResp{
+		Grants: grants,
+		Total:  res.Pagination.GetTotal(),
+	}, nil
+}
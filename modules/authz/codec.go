@@ -0,0 +1,89 @@
+package authz
+
+import (
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	cryptocodec "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/bank"
+	"github.com/irisnet/irishub-sdk-go/modules/coinswap"
+	"github.com/irisnet/irishub-sdk-go/modules/distribution"
+	"github.com/irisnet/irishub-sdk-go/modules/feegrant"
+	"github.com/irisnet/irishub-sdk-go/modules/gov"
+	"github.com/irisnet/irishub-sdk-go/modules/htlc"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/transfer"
+	"github.com/irisnet/irishub-sdk-go/modules/nft"
+	"github.com/irisnet/irishub-sdk-go/modules/oracle"
+	"github.com/irisnet/irishub-sdk-go/modules/random"
+	"github.com/irisnet/irishub-sdk-go/modules/record"
+	"github.com/irisnet/irishub-sdk-go/modules/service"
+	"github.com/irisnet/irishub-sdk-go/modules/slashing"
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+
+	// execCdc marshals the sign bytes of MsgExec, the msgs it wraps are marshaled with their
+	// amino names so it has the msgs of every module registered
+	execCdc = codec.NewAminoCodec(codec.NewLegacyAmino())
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+
+	execCdc.RegisterInterface((*sdk.Msg)(nil), nil)
+	for _, register := range []func(*codec.LegacyAmino){
+		RegisterLegacyAminoCodec,
+		bank.RegisterLegacyAminoCodec,
+		coinswap.RegisterLegacyAminoCodec,
+		distribution.RegisterLegacyAminoCodec,
+		feegrant.RegisterLegacyAminoCodec,
+		gov.RegisterLegacyAminoCodec,
+		htlc.RegisterLegacyAminoCodec,
+		transfer.RegisterLegacyAminoCodec,
+		nft.RegisterLegacyAminoCodec,
+		oracle.RegisterLegacyAminoCodec,
+		random.RegisterLegacyAminoCodec,
+		record.RegisterLegacyAminoCodec,
+		service.RegisterLegacyAminoCodec,
+		slashing.RegisterLegacyAminoCodec,
+		staking.RegisterLegacyAminoCodec,
+		token.RegisterLegacyAminoCodec,
+	} {
+		register(execCdc.LegacyAmino)
+	}
+	cryptocodec.RegisterCrypto(execCdc.LegacyAmino)
+}
+
+// RegisterLegacyAminoCodec registers the necessary authz interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgGrant{}, "cosmos-sdk/MsgGrant", nil)
+	cdc.RegisterConcrete(&MsgRevoke{}, "cosmos-sdk/MsgRevoke", nil)
+	cdc.RegisterConcrete(&MsgExec{}, "cosmos-sdk/MsgExec", nil)
+
+	cdc.RegisterInterface((*Authorization)(nil), nil)
+	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&bank.SendAuthorization{}, "cosmos-sdk/SendAuthorization", nil)
+	cdc.RegisterConcrete(&staking.StakeAuthorization{}, "cosmos-sdk/StakeAuthorization", nil)
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrant{},
+		&MsgRevoke{},
+		&MsgExec{},
+	)
+
+	registry.RegisterInterface(
+		"cosmos.v1beta1.Authorization",
+		(*Authorization)(nil),
+		&GenericAuthorization{},
+		&bank.SendAuthorization{},
+		&staking.StakeAuthorization{},
+	)
+}
@@ -0,0 +1,41 @@
+package authz
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Authz module api for user
+type Client interface {
+	sdk.Module
+	WithContext(ctx context.Context) Client
+
+	Grant(grantee string, authorization Authorization, expiration time.Time, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	Revoke(grantee, msgTypeURL string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	Exec(msgs []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QueryGrants(request QueryGrantsReq) (QueryGrantsResp, sdk.Error)
+}
+
+// QueryGrantsReq queries the grants of the granter to the grantee, both are required
+type QueryGrantsReq struct {
+	Granter string `json:"granter"`
+	Grantee string `json:"grantee"`
+	// the grants of any msg type if empty
+	MsgTypeURL string `json:"msg_type_url"`
+	Page       uint64 `json:"page"`
+	Size       uint64 `json:"size"`
+}
+
+type QueryGrantResp struct {
+	MsgTypeURL    string        `json:"msg_type_url"`
+	Authorization Authorization `json:"authorization"`
+	Expiration    time.Time     `json:"expiration"`
+}
+
+type QueryGrantsResp struct {
+	Grants []QueryGrantResp `json:"grants"`
+	Total  uint64           `json:"total"`
+}
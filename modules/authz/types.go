@@ -0,0 +1,228 @@
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "authz"
+)
+
+var (
+	_ sdk.Msg = &MsgGrant{}
+	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgExec{}
+
+	_ types.UnpackInterfacesMessage = MsgGrant{}
+	_ types.UnpackInterfacesMessage = MsgExec{}
+	_ types.UnpackInterfacesMessage = Grant{}
+
+	_ Authorization = &GenericAuthorization{}
+)
+
+// Authorization grants the grantee the execution of the msgs of a type on behalf of the granter
+type Authorization interface {
+	proto.Message
+
+	// MsgTypeURL returns the type url of the msgs the authorization grants, e.g. /cosmos.gov.v1beta1.MsgVote
+	MsgTypeURL() string
+	ValidateBasic() error
+}
+
+// NewGenericAuthorization returns an unlimited authorization of the msgs of the type url
+func NewGenericAuthorization(msgTypeURL string) *GenericAuthorization {
+	return &GenericAuthorization{
+		Msg: msgTypeURL,
+	}
+}
+
+func (a GenericAuthorization) MsgTypeURL() string { return a.Msg }
+
+func (a GenericAuthorization) ValidateBasic() error {
+	if len(a.Msg) == 0 {
+		return errors.New("msg type url can not be empty")
+	}
+	return nil
+}
+
+func NewGrant(authorization Authorization, expiration time.Time) (Grant, error) {
+	any, err := types.NewAnyWithValue(authorization)
+	if err != nil {
+		return Grant{}, err
+	}
+	return Grant{
+		Authorization: any,
+		Expiration:    expiration,
+	}, nil
+}
+
+// GetAuthorization returns the authorization unpacked by the codec, nil if it was not
+func (g Grant) GetAuthorization() Authorization {
+	a, ok := g.Authorization.GetCachedValue().(Authorization)
+	if !ok {
+		return nil
+	}
+	return a
+}
+
+func (g Grant) ValidateBasic() error {
+	if g.Expiration.Unix() < time.Now().Unix() {
+		return errors.New("expiration must be in the future")
+	}
+	a := g.GetAuthorization()
+	if a == nil {
+		return errors.New("authorization can not be empty")
+	}
+	return a.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var a Authorization
+	return unpacker.UnpackAny(g.Authorization, &a)
+}
+
+func (msg MsgGrant) Route() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgGrant) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgGrant) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgGrant) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	return msg.Grant.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	return msg.Grant.UnpackInterfaces(unpacker)
+}
+
+func (msg MsgRevoke) Route() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgRevoke) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgRevoke) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevoke) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	if len(msg.MsgTypeUrl) == 0 {
+		return errors.New("msg type url can not be empty")
+	}
+	return nil
+}
+
+// NewMsgExec wraps the msgs, whose signer is the granter, to be executed by the grantee
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) (*MsgExec, error) {
+	anys := make([]*types.Any, len(msgs))
+	for i, msg := range msgs {
+		any, err := types.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = any
+	}
+	return &MsgExec{
+		Grantee: grantee.String(),
+		Msgs:    anys,
+	}, nil
+}
+
+// GetMessages returns the msgs unpacked by the codec
+func (msg MsgExec) GetMessages() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(msg.Msgs))
+	for i, any := range msg.Msgs {
+		m, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a msg", any.TypeUrl)
+		}
+		msgs[i] = m
+	}
+	return msgs, nil
+}
+
+func (msg MsgExec) Route() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgExec) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgExec) GetSigners() []sdk.AccAddress {
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{grantee}
+}
+
+func (msg MsgExec) GetSignBytes() []byte {
+	bz := execCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgExec) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Grantee); err != nil {
+		return sdk.WrapWithMessage(err, "invalid grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return errors.New("msgs can not be empty")
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgExec) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	for _, any := range msg.Msgs {
+		var m sdk.Msg
+		if err := unpacker.UnpackAny(any, &m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateGranterGrantee(granter, grantee string) error {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return sdk.WrapWithMessage(err, "invalid granter address")
+	}
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return sdk.WrapWithMessage(err, "invalid grantee address")
+	}
+	if granterAddr.Equals(granteeAddr) {
+		return errors.New("granter and grantee can not be the same")
+	}
+	return nil
+}
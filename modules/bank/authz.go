@@ -0,0 +1,27 @@
+package bank
+
+import (
+	"errors"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// NewSendAuthorization returns an authorization of the grantee to send at most the spend limit on
+// behalf of the granter
+func NewSendAuthorization(spendLimit sdk.Coins) *SendAuthorization {
+	return &SendAuthorization{
+		SpendLimit: spendLimit,
+	}
+}
+
+// MsgTypeURL returns the type url of MsgSend
+func (a SendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+func (a SendAuthorization) ValidateBasic() error {
+	if !a.SpendLimit.IsAllPositive() {
+		return errors.New("spend limit must be positive")
+	}
+	return nil
+}
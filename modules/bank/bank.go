@@ -32,6 +32,15 @@ func (b bankClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 	RegisterInterfaces(registry)
 }
 
+func (b bankClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (b bankClient) WithContext(ctx context.Context) Client {
+	return NewClient(b.BaseClient.WithContext(ctx), b.Marshaler)
+}
+
 // QueryAccount return account information specified address
 func (b bankClient) QueryAccount(address string) (sdk.BaseAccount, sdk.Error) {
 	account, err := b.BaseClient.QueryAccount(address)
@@ -45,13 +54,12 @@ func (b bankClient) QueryAccount(address string) (sdk.BaseAccount, sdk.Error) {
 //  TotalSupply queries the total supply of all coins.
 func (b bankClient) TotalSupply() (sdk.Coins, sdk.Error) {
 	conn, err := b.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).TotalSupply(
-		context.Background(),
+		b.Context(),
 		&QueryTotalSupplyRequest{},
 	)
 	if err != nil {
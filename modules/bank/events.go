@@ -0,0 +1,19 @@
+package bank
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// TransferEvent is emitted by every transfer of coins between accounts
+type TransferEvent struct {
+	Recipient string    `event:"recipient"`
+	Sender    string    `event:"sender"`
+	Amount    sdk.Coins `event:"amount"`
+}
+
+func (TransferEvent) EventType() string { return "transfer" }
+
+// RegisterEvents registers the typed events of the bank module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(TransferEvent{})
+}
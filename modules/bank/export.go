@@ -1,12 +1,15 @@
 package bank
 
 import (
+	"context"
+
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
 // expose bank module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	Send(to string, amount sdk.DecCoins, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	SendWitchSpecAccountInfo(to string, sequence, accountNumber uint64, amount sdk.DecCoins, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
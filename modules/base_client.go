@@ -24,6 +24,7 @@ import (
 	"github.com/irisnet/irishub-sdk-go/codec"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/tx"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
 	"github.com/irisnet/irishub-sdk-go/utils"
 	"github.com/irisnet/irishub-sdk-go/utils/cache"
 	sdklog "github.com/irisnet/irishub-sdk-go/utils/log"
@@ -38,13 +39,19 @@ const (
 )
 
 type baseClient struct {
+	ctx context.Context
 	sdk.TmClient
 	sdk.GRPCClient
 	sdk.KeyManager
+	signer         sdk.Signer
 	logger         log.Logger
 	cfg            *sdk.ClientConfig
 	encodingConfig sdk.EncodingConfig
 	l              *locker
+	seq            *sequenceManager
+	minGasPrices   *minGasPrices
+	// collects the msgs of the module calls instead of sending them, see sdk.MsgCollector
+	collector *sdk.MsgCollector
 
 	accountQuery
 	tokenQuery
@@ -60,9 +67,28 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 		})
 	}
 
+	// the requests fail over among the configured nodes, the grpc addresses follow the health
+	// of the rpc nodes they are paired with
+	rpcEndpoints, grpcEndpoints := cfg.RPCEndpoints(), cfg.GRPCEndpoints()
+	grpcClient := NewBalancedGRPCClient(grpcEndpoints, grpcDialOptions(cfg)...)
+	pool := newNodePool(dialNodes(rpcEndpoints, cfg.Timeout, logger), cfg.HealthCheckInterval,
+		time.Duration(cfg.Timeout)*time.Second, cfg.MaxHeightLag, logger)
+	if len(grpcEndpoints) > 1 && len(grpcEndpoints) == len(rpcEndpoints) {
+		pool.onHealth = func(healthy []int) {
+			addrs := make([]string, len(healthy))
+			for i, index := range healthy {
+				addrs[i] = grpcEndpoints[index]
+			}
+			grpcClient.setEndpoints(addrs)
+		}
+	}
+	_ = pool.Start()
+	subs := newSubscriptions(pool, cfg.Backfill, cfg.SubscriptionEventHandler, logger)
+
 	base := baseClient{
-		TmClient:       NewRPCClient(cfg.NodeURI, encodingConfig.Amino, encodingConfig.TxConfig.TxDecoder(), logger, cfg.Timeout),
-		GRPCClient:     NewGRPCClient(cfg.GRPCAddr),
+		ctx:            context.Background(),
+		TmClient:       newRPCClient(pool, encodingConfig.Amino, encodingConfig.TxConfig.TxDecoder(), logger, subs, cfg.Delivery),
+		GRPCClient:     grpcClient,
 		logger:         logger,
 		cfg:            &cfg,
 		encodingConfig: encodingConfig,
@@ -74,20 +100,31 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 		algo:   cfg.Algo,
 	}
 
+	// the transactions are signed by the external signer if provided
+	base.signer = base.KeyManager
+	if cfg.Signer != nil {
+		base.signer = cfg.Signer
+	}
+
 	c := cache.NewCache(cacheCapacity, cfg.Cached)
 	base.accountQuery = accountQuery{
 		Queries:    base,
 		GRPCClient: base.GRPCClient,
 		Logger:     base.Logger(),
 		Cache:      c,
+		ctx:        base.ctx,
 		cdc:        encodingConfig.Marshaler,
-		km:         base.KeyManager,
+		signer:     base.signer,
 		expiration: cacheExpirePeriod,
 	}
 
+	base.seq = newSequenceManager(base.accountQuery.queryAccount, base.Logger())
+	base.minGasPrices = &minGasPrices{}
+
 	base.tokenQuery = tokenQuery{
 		q:          base,
 		GRPCClient: base.GRPCClient,
+		ctx:        base.ctx,
 		cdc:        encodingConfig.Marshaler,
 		Logger:     base.Logger(),
 		Cache:      c,
@@ -96,6 +133,30 @@ func NewBaseClient(cfg sdk.ClientConfig, encodingConfig sdk.EncodingConfig, logg
 	return &base
 }
 
+// Context returns the context the calls of the client are bound to.
+func (base *baseClient) Context() context.Context {
+	return base.ctx
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx, the
+// copy shares the caches and the sequences of the accounts with the client.
+func (base *baseClient) WithContext(ctx context.Context) sdk.BaseClient {
+	c := *base
+	c.ctx = ctx
+	c.accountQuery.ctx = ctx
+	c.tokenQuery.ctx = ctx
+	if signer, ok := base.signer.(sdk.ContextSigner); ok {
+		c.signer = signer.WithContext(ctx)
+		c.accountQuery.signer = c.signer
+	}
+	if collector, ok := sdk.MsgCollectorFromContext(ctx); ok {
+		c.collector = collector
+		c.signer = collectorSigner{Signer: c.signer, collector: collector}
+		c.accountQuery.signer = c.signer
+	}
+	return &c
+}
+
 func (base *baseClient) Logger() log.Logger {
 	return base.logger
 }
@@ -133,6 +194,19 @@ func (base *baseClient) BuildAndSign(msg []sdk.Msg, baseTx sdk.BaseTx) ([]byte,
 }
 
 func (base *baseClient) BuildAndSend(msg []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	if base.collector != nil {
+		return sdk.ResultTx{}, base.collect(msg)
+	}
+
+	if !baseTx.Simulate && !baseTx.HasSequence() {
+		res, err := base.sendTx(msg, baseTx)
+		if err != nil {
+			base.Logger().Error("broadcast transaction failed", "errMsg", err.Error())
+			return res, err
+		}
+		return res, nil
+	}
+
 	txByte, ctx, err := base.buildTx(msg, baseTx)
 	if err != nil {
 		return sdk.ResultTx{}, err
@@ -155,7 +229,7 @@ func (base *baseClient) BuildAndSend(msg []sdk.Msg, baseTx sdk.BaseTx) (sdk.Resu
 }
 
 func (base *baseClient) BuildAndSendWithAccount(addr string, accountNumber, sequence uint64, msg []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
-	txByte, ctx, err := base.buildTxWithAccount(addr, accountNumber, sequence, msg, baseTx)
+	txByte, ctx, err := base.buildTxWithAccount(addr, accountNumber, sequence, nil, msg, baseTx)
 	if err != nil {
 		return sdk.ResultTx{}, err
 	}
@@ -182,44 +256,36 @@ func (base *baseClient) SendBatch(msgs sdk.Msgs, baseTx sdk.BaseTx) (rs []sdk.Re
 	}
 	base.Logger().Debug("validate msg success")
 
+	if base.collector != nil {
+		base.collector.Collect(msgs...)
+		return rs, sdk.ErrMsgCollected
+	}
+
 	// lock the account
 	base.l.Lock(baseTx.From)
 	defer base.l.Unlock(baseTx.From)
 
 	batch := maxBatch
-	var tryCnt = 0
 
 resize:
 	for i, ms := range utils.SubArray(batch, msgs) {
 		mss := ms.(sdk.Msgs)
 
-	retry:
-		txByte, ctx, err := base.buildTx(mss, baseTx)
-		if err != nil {
-			return rs, err
-		}
-
-		if err := base.ValidateTxSize(len(txByte), mss); err != nil {
-			base.Logger().Debug("tx is too large", "msgsLength", batch, "errMsg", err.Error())
-
-			// filter out transactions that have been sent
-			msgs = msgs[i*batch:]
-			// reset the maximum number of msg in each transaction
-			batch = batch / 2
-			_ = base.removeCache(ctx.Address())
-			goto resize
+		if e := base.Context().Err(); e != nil {
+			return rs, sdk.Wrap(e)
 		}
 
-		res, err := base.broadcastTx(txByte, ctx.Mode(), baseTx.Simulate)
+		res, err := base.sendTx(mss, baseTx)
 		if err != nil {
-			if base.cfg.Cached {
-				base.Logger().Debug("something wrong,retrying ...", "address", ctx.Address(), "tryCnt", tryCnt)
-
-				_ = base.removeCache(ctx.Address())
-				if tryCnt++; tryCnt >= tryThreshold {
-					return rs, err
-				}
-				goto retry
+			// the tx exceeds MaxTxBytes or is rejected by the node for its size
+			if err.Codespace() == sdk.RootCodespace && err.Code() == uint32(sdk.TxTooLarge) && batch > 1 {
+				base.Logger().Debug("tx is too large", "msgsLength", batch, "errMsg", err.Error())
+
+				// filter out transactions that have been sent
+				msgs = msgs[i*batch:]
+				// reset the maximum number of msg in each transaction
+				batch = batch / 2
+				goto resize
 			}
 
 			base.Logger().Error("broadcast transaction failed", "errMsg", err.Error())
@@ -232,6 +298,120 @@ resize:
 	return rs, nil
 }
 
+// sendTx builds, signs and broadcasts the msgs with a sequence handed out by the sequence manager,
+// the tx is rebuilt with a new sequence when the node rejects it with a sequence mismatch. The
+// sequence is used once CheckTx accepts the tx, a tx of commit mode is broadcast synchronously
+// and waited for without holding the account, so that the next sender can go on. A fee payer
+// other than the sender gets its sequence from the sequence manager as well.
+func (base *baseClient) sendTx(msgs []sdk.Msg, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	addr, err := base.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, err
+	}
+	address := addr.String()
+
+	signers := []string{address}
+	if len(baseTx.FeePayer) > 0 {
+		payer, err := base.QueryAddress(baseTx.FeePayer, baseTx.FeePayerPassword)
+		if err != nil {
+			return sdk.ResultTx{}, err
+		}
+		if payer.String() != address {
+			signers = append(signers, payer.String())
+		}
+	}
+
+	for tryCnt := 0; ; tryCnt++ {
+		if tryCnt > 0 {
+			if e := base.Context().Err(); e != nil {
+				return sdk.ResultTx{}, sdk.Wrap(e)
+			}
+			base.seq.retried(address)
+		}
+
+		tickets, e := base.seq.acquireAll(base.Context(), signers...)
+		if e != nil {
+			return sdk.ResultTx{}, sdk.Wrap(e)
+		}
+		ticket := tickets[0]
+		var payer *sequenceTicket
+		if len(tickets) > 1 {
+			payer = &tickets[1]
+		}
+
+		txByte, ctx, err := base.buildTxWithAccount(address, ticket.accountNumber, ticket.sequence, payer, msgs, baseTx)
+		if err != nil {
+			for _, t := range tickets {
+				base.seq.release(t, false)
+			}
+			return sdk.ResultTx{}, err
+		}
+
+		if err := base.ValidateTxSize(len(txByte), msgs); err != nil {
+			for _, t := range tickets {
+				base.seq.release(t, false)
+			}
+			return sdk.ResultTx{}, err
+		}
+
+		mode := ctx.Mode()
+		if mode == sdk.Commit {
+			mode = sdk.Sync
+		}
+
+		res, err := base.broadcastTx(txByte, mode, false)
+		if isSequenceMismatch(err) && tryCnt < maxSequenceRetries {
+			base.Logger().Debug("account sequence mismatch, retrying ...", "address", address, "tryCnt", tryCnt)
+			base.seq.resyncAll(tickets, err.Error())
+			continue
+		}
+
+		if err != nil || ctx.Mode() != sdk.Commit {
+			for _, t := range tickets {
+				base.seq.release(t, err == nil)
+			}
+			return res, err
+		}
+
+		for _, t := range tickets {
+			base.seq.accept(t)
+		}
+		res, committed, err := base.waitForCommit(res)
+		for _, t := range tickets {
+			base.seq.settle(t, committed)
+		}
+		return res, err
+	}
+}
+
+// waitForCommit waits up to CommitTimeout for the tx accepted by CheckTx to be committed as
+// BroadcastTxCommit does, the tx failed in DeliverTx is committed as well and returned with its error
+func (base *baseClient) waitForCommit(res sdk.ResultTx) (sdk.ResultTx, bool, sdk.Error) {
+	ctx, cancel := context.WithTimeout(base.Context(), base.cfg.CommitTimeout)
+	defer cancel()
+
+	tx, err := base.WaitForTx(ctx, res, sdk.ConfirmOptions{})
+	if err != nil {
+		return sdk.ResultTx{}, false, err
+	}
+
+	if tx.Result.Code != 0 {
+		return sdk.ResultTx{}, true, sdk.GetError(tx.Result.Codespace, tx.Result.Code, tx.Result.Log)
+	}
+	return sdk.ResultTx{
+		GasWanted: tx.Result.GasWanted,
+		GasUsed:   tx.Result.GasUsed,
+		Events:    tx.Result.Events,
+		Hash:      tx.Hash,
+		Height:    tx.Height,
+	}, true, nil
+}
+
+// SequenceMetrics returns the metrics of the sequences handed out to the senders of the address
+func (base *baseClient) SequenceMetrics(address string) sdk.SequenceMetrics {
+	return base.seq.metrics(address)
+}
+
 func (base baseClient) QueryWithResponse(path string, data interface{}, result sdk.Response) error {
 	res, err := base.Query(path, data)
 	if err != nil {
@@ -259,7 +439,7 @@ func (base baseClient) Query(path string, data interface{}) ([]byte, error) {
 		// Height: cliCtx.Height,
 		Prove: false,
 	}
-	result, err := base.ABCIQueryWithOptions(context.Background(), path, bz, opts)
+	result, err := base.ABCIQueryWithOptions(base.Context(), path, bz, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -272,6 +452,21 @@ func (base baseClient) Query(path string, data interface{}) ([]byte, error) {
 	return resp.Value, nil
 }
 
+// queryWithData performs an abci query with the raw data and returns the value
+// of the response with the height it was executed at.
+func (base baseClient) queryWithData(path string, data []byte) ([]byte, int64, error) {
+	result, err := base.ABCIQuery(base.Context(), path, data)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return nil, 0, errors.New(resp.Log)
+	}
+	return resp.Value, resp.Height, nil
+}
+
 func (base baseClient) QueryStore(key sdk.HexBytes, storeName string, height int64, prove bool) (res abci.ResponseQuery, err error) {
 	path := fmt.Sprintf("/store/%s/%s", storeName, "key")
 	opts := rpcclient.ABCIQueryOptions{
@@ -279,7 +474,7 @@ func (base baseClient) QueryStore(key sdk.HexBytes, storeName string, height int
 		Height: height,
 	}
 
-	result, err := base.ABCIQueryWithOptions(context.Background(), path, key, opts)
+	result, err := base.ABCIQueryWithOptions(base.Context(), path, key, opts)
 	if err != nil {
 		return res, err
 	}
@@ -291,13 +486,26 @@ func (base baseClient) QueryStore(key sdk.HexBytes, storeName string, height int
 	return resp, nil
 }
 
+// autoGas reports whether the gas of the transaction is estimated by simulation before
+// broadcasting. An explicit gas of the BaseTx always wins, and a tx that is only
+// simulated doesn't need to be simulated twice.
+func (base *baseClient) autoGas(baseTx sdk.BaseTx) bool {
+	if baseTx.Simulate || baseTx.Gas > 0 {
+		return false
+	}
+	return base.cfg.AutoGas || baseTx.AutoGas
+}
+
 func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 	factory := clienttx.NewFactory().
 		WithChainID(base.cfg.ChainID).
-		WithKeyManager(base.KeyManager).
+		WithSigner(base.signer).
 		WithMode(base.cfg.Mode).
-		WithSimulateAndExecute(baseTx.Simulate).
+		WithSimulateAndExecute(base.autoGas(baseTx)).
 		WithGas(base.cfg.Gas).
+		WithGasAdjustment(base.cfg.GasAdjustment).
+		WithQueryFunc(base.queryWithData).
+		WithSignMode(base.cfg.SignMode).
 		WithSignModeHandler(tx.MakeSignModeHandler(tx.DefaultSignModes)).
 		WithTxConfig(base.encodingConfig.TxConfig)
 
@@ -307,56 +515,168 @@ func (base *baseClient) prepare(baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 	}
 	factory.WithAddress(addr.String())
 
-	if baseTx.AccountNumber != 0 && baseTx.Sequence != 0 {
-		factory.WithAccountNumber(baseTx.AccountNumber).
-			WithSequence(baseTx.Sequence).
-			WithPassword(baseTx.Password)
-	} else {
+	accountNumber, sequence := baseTx.AccountNumber, baseTx.Sequence
+	if !baseTx.HasAccountNumber() || !baseTx.HasSequence() {
 		account, err := base.QueryAndRefreshAccount(addr.String())
 		if err != nil {
 			return nil, err
 		}
-		factory.WithAccountNumber(account.AccountNumber).
-			WithSequence(account.Sequence).
-			WithPassword(baseTx.Password)
+		if !baseTx.HasAccountNumber() {
+			accountNumber = account.AccountNumber
+		}
+		if !baseTx.HasSequence() {
+			sequence = account.Sequence
+		}
 	}
+	factory.WithAccountNumber(accountNumber).
+		WithSequence(sequence).
+		WithPassword(baseTx.Password)
 
-	if !baseTx.Fee.Empty() && baseTx.Fee.IsValid() {
+	if err := base.prepareFee(factory, baseTx); err != nil {
+		return nil, err
+	}
+
+	if err := base.prepareFeePayment(factory, baseTx, false, nil); err != nil {
+		return nil, err
+	}
+
+	if len(baseTx.Mode) > 0 {
+		factory.WithMode(baseTx.Mode)
+	}
+
+	if baseTx.Gas > 0 {
+		factory.WithGas(baseTx.Gas)
+	}
+
+	if len(baseTx.Memo) > 0 {
+		factory.WithMemo(baseTx.Memo)
+	}
+
+	if baseTx.SignMode != signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		factory.WithSignMode(baseTx.SignMode)
+	}
+	return factory, nil
+}
+
+// prepareFee sets the fee of the transaction. The fee of the BaseTx wins over the gas prices,
+// which win over the fee of the ClientConfig. The gas prices are the minimum gas prices of the
+// node if none is given and AutoGasPrices is enabled. The maximum fee, if any, is checked against
+// the fee computed with the final gas.
+func (base *baseClient) prepareFee(factory *clienttx.Factory, baseTx sdk.BaseTx) error {
+	gasPrices := baseTx.GasPrices
+	if gasPrices.Empty() {
+		gasPrices = base.cfg.GasPrices
+	}
+	if gasPrices.Empty() && baseTx.Fee.Empty() && base.cfg.AutoGasPrices {
+		minGasPrices, err := base.QueryMinGasPrices()
+		if err != nil {
+			return err
+		}
+		gasPrices = minGasPrices
+	}
+
+	switch {
+	case !baseTx.Fee.Empty() && baseTx.Fee.IsValid():
 		fees, err := base.ToMinCoin(baseTx.Fee...)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		factory.WithFee(fees)
-	} else {
+	case !gasPrices.Empty():
+		if !gasPrices.IsValid() {
+			return sdk.Wrapf("invalid gas prices: %s", gasPrices)
+		}
+		minGasPrices, err := base.ToMinDecCoin(gasPrices...)
+		if err != nil {
+			return err
+		}
+		factory.WithGasPrices(minGasPrices)
+	default:
 		fees, err := base.ToMinCoin(base.cfg.Fee...)
 		if err != nil {
-			panic(err)
+			return err
 		}
 		factory.WithFee(fees)
 	}
 
-	if len(baseTx.Mode) > 0 {
-		factory.WithMode(baseTx.Mode)
+	maxFee := baseTx.MaxFee
+	if maxFee.Empty() {
+		maxFee = base.cfg.MaxFee
 	}
+	if !maxFee.Empty() {
+		maxFees, err := base.ToMinCoin(maxFee...)
+		if err != nil {
+			return err
+		}
+		factory.WithMaxFees(maxFees)
+	}
+	return nil
+}
 
-	if baseTx.Gas > 0 {
-		factory.WithGas(baseTx.Gas)
+// prepareFeePayment sets the fee granter and the fee payer of the transaction. A fee payer other
+// than the sender co-signs the transaction with the key baseTx.FeePayer, unless the transaction
+// is built offline, where the payer may be given by its address and signs with SignMultisigTx.
+// The payer signs with the sequence of its ticket if any, or with the one of its account otherwise.
+func (base *baseClient) prepareFeePayment(factory *clienttx.Factory, baseTx sdk.BaseTx, offline bool, ticket *sequenceTicket) error {
+	if len(baseTx.FeeGranter) > 0 {
+		granter, err := sdk.AccAddressFromBech32(baseTx.FeeGranter)
+		if err != nil {
+			return sdk.Wrapf("invalid fee granter %s: %s", baseTx.FeeGranter, err.Error())
+		}
+		factory.WithFeeGranter(granter)
 	}
 
-	if len(baseTx.Memo) > 0 {
-		factory.WithMemo(baseTx.Memo)
+	if len(baseTx.FeePayer) == 0 {
+		return nil
 	}
-	return factory, nil
+
+	if offline {
+		if payer, err := sdk.AccAddressFromBech32(baseTx.FeePayer); err == nil {
+			factory.WithFeePayer(payer)
+			return nil
+		}
+	}
+
+	payer, err := base.QueryAddress(baseTx.FeePayer, baseTx.FeePayerPassword)
+	if err != nil {
+		return err
+	}
+	factory.WithFeePayer(payer)
+
+	if offline || payer.String() == factory.Address() {
+		return nil
+	}
+
+	var accountNumber, sequence uint64
+	if ticket != nil {
+		accountNumber, sequence = ticket.accountNumber, ticket.sequence
+	} else {
+		account, err := base.QueryAccount(payer.String())
+		if err != nil {
+			return err
+		}
+		accountNumber, sequence = account.AccountNumber, account.Sequence
+	}
+	factory.WithCoSigners(clienttx.CoSigner{
+		Name:          baseTx.FeePayer,
+		Password:      baseTx.FeePayerPassword,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+	})
+	return nil
 }
 
 // TODO
 func (base *baseClient) prepareTemp(addr string, accountNumber, sequence uint64, baseTx sdk.BaseTx) (*clienttx.Factory, error) {
 	factory := clienttx.NewFactory().
 		WithChainID(base.cfg.ChainID).
-		WithKeyManager(base.KeyManager).
+		WithSigner(base.signer).
 		WithMode(base.cfg.Mode).
-		WithSimulateAndExecute(baseTx.Simulate).
+		WithSimulateAndExecute(base.autoGas(baseTx)).
 		WithGas(base.cfg.Gas).
+		WithGasAdjustment(base.cfg.GasAdjustment).
+		WithQueryFunc(base.queryWithData).
+		WithSignMode(base.cfg.SignMode).
 		WithSignModeHandler(tx.MakeSignModeHandler(tx.DefaultSignModes)).
 		WithTxConfig(base.encodingConfig.TxConfig)
 
@@ -365,18 +685,8 @@ func (base *baseClient) prepareTemp(addr string, accountNumber, sequence uint64,
 		WithSequence(sequence).
 		WithPassword(baseTx.Password)
 
-	if !baseTx.Fee.Empty() && baseTx.Fee.IsValid() {
-		fees, err := base.ToMinCoin(baseTx.Fee...)
-		if err != nil {
-			return nil, err
-		}
-		factory.WithFee(fees)
-	} else {
-		fees, err := base.ToMinCoin(base.cfg.Fee...)
-		if err != nil {
-			panic(err)
-		}
-		factory.WithFee(fees)
+	if err := base.prepareFee(factory, baseTx); err != nil {
+		return nil, err
 	}
 
 	if len(baseTx.Mode) > 0 {
@@ -390,35 +700,19 @@ func (base *baseClient) prepareTemp(addr string, accountNumber, sequence uint64,
 	if len(baseTx.Memo) > 0 {
 		factory.WithMemo(baseTx.Memo)
 	}
+
+	if baseTx.SignMode != signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		factory.WithSignMode(baseTx.SignMode)
+	}
 	return factory, nil
 }
 
+// ValidateTxSize returns a TxTooLarge error when the tx exceeds the MaxTxBytes of the config
 func (base *baseClient) ValidateTxSize(txSize int, msgs []sdk.Msg) sdk.Error {
-	//var isServiceTx bool
-	//for _, msg := range msgs {
-	//	if msg.Route() == service.ModuleName {
-	//		isServiceTx = true
-	//		break
-	//	}
-	//}
-	//if isServiceTx {
-	//	var param service.Params
-	//
-	//	err := base.QueryParams(service.ModuleName, &param)
-	//	if err != nil {
-	//		panic(err)
-	//	}
-	//
-	//	if uint64(txSize) > param.TxSizeLimit {
-	//		return sdk.Wrapf("tx size too large, expected: <= %d, got %d", param.TxSizeLimit, txSize)
-	//	}
-	//	return nil
-	//
-	//} else {
-	//	if uint64(txSize) > base.cfg.MaxTxBytes {
-	//		return sdk.Wrapf("tx size too large, expected: <= %d, got %d", base.cfg.MaxTxBytes, txSize)
-	//	}
-	//}
+	if uint64(txSize) > base.cfg.MaxTxBytes {
+		return sdk.GetError(sdk.RootCodespace, uint32(sdk.TxTooLarge),
+			fmt.Sprintf("tx size too large, expected: <= %d, got %d", base.cfg.MaxTxBytes, txSize))
+	}
 	return nil
 }
 
@@ -35,6 +35,15 @@ func (swap coinswapClient) RegisterInterfaceTypes(registry types.InterfaceRegist
 	RegisterInterfaces(registry)
 }
 
+func (swap coinswapClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (swap coinswapClient) WithContext(ctx context.Context) Client {
+	return NewClient(swap.BaseClient.WithContext(ctx), swap.Marshaler, swap.totalSupply)
+}
+
 func (swap coinswapClient) AddLiquidity(request AddLiquidityRequest,
 	baseTx sdk.BaseTx) (*AddLiquidityResponse, error) {
 	creator, err := swap.QueryAddress(baseTx.From, baseTx.Password)
@@ -156,15 +165,11 @@ func (swap coinswapClient) SwapCoin(request SwapCoinRequest, baseTx sdk.BaseTx)
 		return nil, err
 	}
 
-	amount, er := res.Events.GetValue(eventTypeSwap, attributeKeyAmount)
-	if er != nil {
+	var event SwapEvent
+	if er := sdk.DecodeEvent(res.Events, &event); er != nil {
 		return nil, er
 	}
-
-	amt, ok := sdk.NewIntFromString(amount)
-	if !ok {
-		return nil, sdk.Wrapf("%s can not convert to sdk.Int type", amount)
-	}
+	amt := event.Amount
 
 	inputAmt := request.Input.Amount
 	outputAmt := request.Output.Amount
@@ -244,13 +249,12 @@ func (swap coinswapClient) SellTokenWithAutoEstimate(gotTokenDenom string, soldC
 
 func (swap coinswapClient) QueryPool(lptDenom string) (*QueryPoolResponse, error) {
 	conn, err := swap.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).LiquidityPool(
-		context.Background(),
+		swap.Context(),
 		&QueryLiquidityPoolRequest{LptDenom: lptDenom},
 	)
 	if err != nil {
@@ -261,13 +265,12 @@ func (swap coinswapClient) QueryPool(lptDenom string) (*QueryPoolResponse, error
 
 func (swap coinswapClient) QueryAllPools(req sdk.PageRequest) (*QueryAllPoolsResponse, error) {
 	conn, err := swap.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).LiquidityPools(
-		context.Background(),
+		swap.Context(),
 		&QueryLiquidityPoolsRequest{
 			Pagination: &query.PageRequest{
 				Key:        req.Key,
@@ -283,6 +286,39 @@ func (swap coinswapClient) QueryAllPools(req sdk.PageRequest) (*QueryAllPoolsRes
 	return resp.Convert().(*QueryAllPoolsResponse), err
 }
 
+// IterateAllPools calls the callback with the liquidity pools page by page, until it returns false
+// or the pools are exhausted
+func (swap coinswapClient) IterateAllPools(opts sdk.PageOptions, callback func(sdk.PoolInfo) bool) error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := swap.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := NewQueryClient(conn).LiquidityPools(
+			swap.Context(),
+			&QueryLiquidityPoolsRequest{
+				Pagination: &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(resp.Pools))
+		for i, pool := range resp.Pools {
+			items[i] = _loadPoolInfo(pool)
+		}
+		return items, resp.GetPagination().GetNextKey(), nil
+	}, opts)
+	if err := it.Each(func(item interface{}) bool {
+		return callback(item.(sdk.PoolInfo))
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (swap coinswapClient) EstimateTokenForSoldBase(tokenDenom string,
 	soldBaseAmt sdk.Int,
 ) (sdk.Int, error) {
@@ -389,3 +425,16 @@ func getOutputPrice(outputAmt, inputReserve, outputReserve sdk.Int, fee sdk.Dec)
 	denominator := (outputReserve.Sub(outputAmt)).Mul(sdk.NewIntFromBigInt(deltaFee.BigInt()))
 	return numerator.Quo(denominator).Add(sdk.OneInt())
 }
+
+// SubscribeSwapTx subscribes the swaps in the pool of the token denom, which is paid or bought by
+// the swaps, an empty denom matches any pool
+func (swap coinswapClient) SubscribeSwapTx(denom string, callback EventMsgSwapOrderCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgSwapOrder{}.Type())
+	return sdk.SubscribeMsgs(swap.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgSwapOrder)
+		if !ok || (len(denom) > 0 && msg.Input.Coin.Denom != denom && msg.Output.Coin.Denom != denom) {
+			return
+		}
+		callback(msg, data)
+	})
+}
@@ -0,0 +1,21 @@
+package coinswap
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type SwapEvent struct {
+	Amount     sdk.Int `event:"amount,required"`
+	Sender     string  `event:"sender"`
+	Recipient  string  `event:"recipient"`
+	IsBuyOrder bool    `event:"is_buy_order"`
+	TokenPair  string  `event:"token_pair"`
+}
+
+func (SwapEvent) EventType() string { return eventTypeSwap }
+
+// RegisterEvents registers the typed events of the coinswap module, the transfers of the
+// liquidity are bank.TransferEvent
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(SwapEvent{})
+}
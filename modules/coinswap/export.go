@@ -1,6 +1,8 @@
 package coinswap
 
 import (
+	"context"
+
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/types/query"
 )
@@ -8,6 +10,7 @@ import (
 // expose Record module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 	AddLiquidity(request AddLiquidityRequest,
 		baseTx sdk.BaseTx) (*AddLiquidityResponse, error)
 	RemoveLiquidity(request RemoveLiquidityRequest,
@@ -26,6 +29,7 @@ type Client interface {
 
 	QueryPool(lptDenom string) (*QueryPoolResponse, error)
 	QueryAllPools(pageReq sdk.PageRequest) (*QueryAllPoolsResponse, error)
+	IterateAllPools(opts sdk.PageOptions, callback func(sdk.PoolInfo) bool) error
 
 	EstimateTokenForSoldBase(tokenDenom string,
 		soldBase sdk.Int,
@@ -38,6 +42,7 @@ type Client interface {
 	EstimateBaseForBoughtToken(boughtToken sdk.Coin) (sdk.Int, error)
 	EstimateTokenForBoughtToken(soldTokenDenom string,
 		boughtToken sdk.Coin) (sdk.Int, error)
+	SubscribeSwapTx(denom string, callback EventMsgSwapOrderCallback) (sdk.Subscription, sdk.Error)
 }
 
 type AddLiquidityRequest struct {
@@ -90,3 +95,5 @@ type QueryAllPoolsResponse struct {
 	Pools      []sdk.PoolInfo
 	Pagination *query.PageResponse
 }
+
+type EventMsgSwapOrderCallback func(msg *MsgSwapOrder, data sdk.EventDataMsg)
@@ -0,0 +1,33 @@
+package modules
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// collect validates the msgs and hands them to the collector instead of sending them, the call
+// returns ErrMsgCollected so that the module calls return before reading the result of the tx
+func (base *baseClient) collect(msgs []sdk.Msg) sdk.Error {
+	for _, m := range msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return sdk.Wrap(err)
+		}
+	}
+	base.collector.Collect(msgs...)
+	return sdk.ErrMsgCollected
+}
+
+// collectorSigner finds the signers of the collector by their address, the msgs of the collected
+// calls are not signed so their keys don't need to be in the KeyDAO
+type collectorSigner struct {
+	sdk.Signer
+	collector *sdk.MsgCollector
+}
+
+func (s collectorSigner) Find(name, password string) (crypto.PubKey, sdk.AccAddress, error) {
+	if address, ok := s.collector.Signer(name); ok {
+		return nil, address, nil
+	}
+	return s.Signer.Find(name, password)
+}
@@ -0,0 +1,70 @@
+package modules
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const defaultPollInterval = 1 * time.Second
+
+// WaitForTx waits until the broadcast tx is included in a block and returns it with the result of
+// DeliverTx, a tx failed in DeliverTx is returned with a non-zero Result.Code. The node is notified
+// by the websocket subscription of the tx and polled periodically, an error is returned when the
+// chain reaches opts.TimeoutHeight without the tx or when the context is done.
+func (base *baseClient) WaitForTx(ctx context.Context, res sdk.ResultTx, opts sdk.ConfirmOptions) (sdk.ResultQueryTx, sdk.Error) {
+	if len(res.Hash) == 0 {
+		return sdk.ResultQueryTx{}, sdk.Wrapf("tx hash required but not specified")
+	}
+
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+
+	included := make(chan struct{}, 1)
+	builder := sdk.NewEventQueryBuilder().AddCondition(sdk.Cond(sdk.TxHashKey).EQ(res.Hash))
+	subscription, err := base.SubscribeTx(builder, func(sdk.EventDataTx) {
+		select {
+		case included <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		base.Logger().Debug("subscribe tx failed, polling the node", "hash", res.Hash, "errMsg", err.Error())
+	} else {
+		defer func() { _ = base.Unsubscribe(subscription) }()
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// the queries are bound to ctx as well
+	client := base.WithContext(ctx)
+	for {
+		// the tx may have been included before the subscription
+		tx, err := client.QueryTx(res.Hash)
+		if err == nil {
+			return tx, nil
+		}
+
+		if opts.TimeoutHeight > 0 {
+			status, err := base.Status(ctx)
+			if err == nil && status.SyncInfo.LatestBlockHeight >= opts.TimeoutHeight {
+				// the tx may have been included after the query, up to the timeout height
+				if tx, err := client.QueryTx(res.Hash); err == nil {
+					return tx, nil
+				}
+				return sdk.ResultQueryTx{}, sdk.Wrapf("tx %s not included before height %d", res.Hash, opts.TimeoutHeight)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return sdk.ResultQueryTx{}, sdk.Wrap(ctx.Err())
+		case <-included:
+		case <-ticker.C:
+		}
+	}
+}
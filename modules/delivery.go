@@ -0,0 +1,173 @@
+package modules
+
+import (
+	"errors"
+	"hash/fnv"
+	"sync"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+var errQueueFull = errors.New("event queue of the subscription is full")
+
+// dispatcher queues the events of a subscription and passes them to the handler from a fixed
+// number of workers, the events with the same key go through the queue of the same worker.
+// The events are dispatched by a single goroutine at a time.
+type dispatcher struct {
+	opts     sdk.DeliveryOptions
+	queues   []chan sdk.EventData
+	overflow func(err error)
+
+	mu           sync.Mutex
+	next         int
+	closed       bool
+	done         chan struct{}
+	closeOnce    sync.Once
+	overflowOnce sync.Once
+}
+
+// newDispatcher starts the workers calling the handler, overflow is called once
+// when an event is rejected by the OverflowError policy
+func newDispatcher(opts sdk.DeliveryOptions, handler func(data sdk.EventData), overflow func(err error)) *dispatcher {
+	d := newQueues(opts, overflow)
+	for _, queue := range d.queues {
+		go func(queue chan sdk.EventData) {
+			for data := range queue {
+				handler(data)
+			}
+		}(queue)
+	}
+	return d
+}
+
+// newChanDispatcher returns a dispatcher whose single queue is read by the caller
+func newChanDispatcher(opts sdk.DeliveryOptions, overflow func(err error)) (*dispatcher, <-chan sdk.EventData) {
+	opts.Workers = 1
+	d := newQueues(opts, overflow)
+	return d, d.queues[0]
+}
+
+func newQueues(opts sdk.DeliveryOptions, overflow func(err error)) *dispatcher {
+	opts = opts.WithDefaults()
+	d := &dispatcher{
+		opts:     opts,
+		queues:   make([]chan sdk.EventData, opts.Workers),
+		overflow: overflow,
+		done:     make(chan struct{}),
+	}
+	for i := range d.queues {
+		d.queues[i] = make(chan sdk.EventData, opts.QueueSize)
+	}
+	return d
+}
+
+// dispatch queues the event according to the overflow policy, it returns false if
+// the event has been rejected or the dispatcher is closed
+func (d *dispatcher) dispatch(data sdk.EventData) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.closed {
+		return false
+	}
+	queue := d.queue(data)
+
+	select {
+	case queue <- data:
+		return true
+	default:
+	}
+
+	switch d.opts.Overflow {
+	case sdk.OverflowDropOldest:
+		for {
+			select {
+			case queue <- data:
+				return true
+			default:
+			}
+			select {
+			case <-queue:
+			default:
+			}
+		}
+	case sdk.OverflowError:
+		if d.overflow != nil {
+			d.overflowOnce.Do(func() { go d.overflow(errQueueFull) })
+		}
+		return false
+	default:
+		select {
+		case queue <- data:
+			return true
+		case <-d.done:
+			return false
+		}
+	}
+}
+
+// queue returns the queue of the worker handling the key of the event, d.mu must be held
+func (d *dispatcher) queue(data sdk.EventData) chan sdk.EventData {
+	if len(d.queues) == 1 {
+		return d.queues[0]
+	}
+
+	if d.opts.Key != nil {
+		if key := d.opts.Key(data); len(key) > 0 {
+			h := fnv.New32a()
+			_, _ = h.Write([]byte(key))
+			return d.queues[h.Sum32()%uint32(len(d.queues))]
+		}
+	}
+
+	d.next = (d.next + 1) % len(d.queues)
+	return d.queues[d.next]
+}
+
+// close stops the dispatcher, the queued events are still handled by the workers
+func (d *dispatcher) close() {
+	d.closeOnce.Do(func() {
+		// a dispatch blocked on a full queue returns once done is closed
+		close(d.done)
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+		d.closed = true
+		for _, queue := range d.queues {
+			close(queue)
+		}
+	})
+}
+
+// recentKeysSize is the number of the keys of the events remembered to drop the duplicates of an OR subscription
+const recentKeysSize = 10000
+
+// recentKeys remembers the last keys added
+type recentKeys struct {
+	mu   sync.Mutex
+	keys map[string]bool
+	ring []string
+	next int
+}
+
+func newRecentKeys(size int) *recentKeys {
+	return &recentKeys{
+		keys: make(map[string]bool, size),
+		ring: make([]string, size),
+	}
+}
+
+// add returns false if the key is one of the last keys added
+func (r *recentKeys) add(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.keys[key] {
+		return false
+	}
+	delete(r.keys, r.ring[r.next])
+	r.ring[r.next] = key
+	r.keys[key] = true
+	r.next = (r.next + 1) % len(r.ring)
+	return true
+}
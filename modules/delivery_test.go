@@ -0,0 +1,113 @@
+package modules
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestDispatcherOrder(t *testing.T) {
+	// a single worker handles the events in order
+	var handled []int
+	done := make(chan struct{})
+	d := newDispatcher(sdk.DeliveryOptions{QueueSize: 1}, func(data sdk.EventData) {
+		handled = append(handled, data.(int))
+		if len(handled) == 100 {
+			close(done)
+		}
+	}, nil)
+	for i := 0; i < 100; i++ {
+		require.True(t, d.dispatch(i))
+	}
+	<-done
+	for i, v := range handled {
+		require.Equal(t, i, v)
+	}
+	d.close()
+	require.False(t, d.dispatch(100))
+
+	// the events of a key are handled in order by the same worker
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	byKey := make(map[string][]int)
+	d = newDispatcher(sdk.DeliveryOptions{
+		QueueSize: 10,
+		Workers:   4,
+		Key:       func(data sdk.EventData) string { return fmt.Sprint(data.(int) % 3) },
+	}, func(data sdk.EventData) {
+		defer wg.Done()
+		mu.Lock()
+		defer mu.Unlock()
+		key := fmt.Sprint(data.(int) % 3)
+		byKey[key] = append(byKey[key], data.(int))
+	}, nil)
+	wg.Add(90)
+	for i := 0; i < 90; i++ {
+		require.True(t, d.dispatch(i))
+	}
+	wg.Wait()
+	d.close()
+
+	require.Len(t, byKey, 3)
+	for key, values := range byKey {
+		require.Len(t, values, 30)
+		for i, v := range values {
+			require.Equal(t, fmt.Sprintf("%d", v%3), key)
+			require.Equal(t, 3*i+v%3, v)
+		}
+	}
+}
+
+func TestDispatcherOverflow(t *testing.T) {
+	// the oldest events are dropped
+	d, ch := newChanDispatcher(sdk.DeliveryOptions{QueueSize: 2, Overflow: sdk.OverflowDropOldest}, nil)
+	for i := 0; i < 5; i++ {
+		require.True(t, d.dispatch(i))
+	}
+	require.Equal(t, 3, <-ch)
+	require.Equal(t, 4, <-ch)
+
+	// the subscription is cancelled once
+	overflowed := make(chan error, 2)
+	d, ch = newChanDispatcher(sdk.DeliveryOptions{QueueSize: 1, Overflow: sdk.OverflowError}, func(err error) {
+		overflowed <- err
+	})
+	require.True(t, d.dispatch(0))
+	require.False(t, d.dispatch(1))
+	require.False(t, d.dispatch(2))
+	require.Equal(t, errQueueFull, <-overflowed)
+	d.close()
+	require.Equal(t, 0, <-ch)
+	_, ok := <-ch
+	require.False(t, ok)
+	require.Empty(t, overflowed)
+
+	// a dispatch blocked on a full queue returns when the subscription is cancelled
+	d, _ = newChanDispatcher(sdk.DeliveryOptions{QueueSize: 1}, nil)
+	require.True(t, d.dispatch(0))
+	blocked := make(chan bool)
+	go func() { blocked <- d.dispatch(1) }()
+	select {
+	case <-blocked:
+		t.Fatal("dispatch should block on a full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+	d.close()
+	require.False(t, <-blocked)
+}
+
+func TestRecentKeys(t *testing.T) {
+	keys := newRecentKeys(2)
+	require.True(t, keys.add("a"))
+	require.False(t, keys.add("a"))
+	require.True(t, keys.add("b"))
+	// a is forgotten once two other keys are added
+	require.True(t, keys.add("c"))
+	require.True(t, keys.add("a"))
+	require.False(t, keys.add("c"))
+}
@@ -0,0 +1,302 @@
+package distribution
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type distributionClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &distributionClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (dc distributionClient) Name() string {
+	return ModuleName
+}
+
+func (dc distributionClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (dc distributionClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (dc distributionClient) WithContext(ctx context.Context) Client {
+	return NewClient(dc.BaseClient.WithContext(ctx), dc.Marshaler)
+}
+
+// WithdrawDelegatorReward withdraws the rewards of the delegation of the sender to the validator
+func (dc distributionClient) WithdrawDelegatorReward(validatorAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	delegatorAddr, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgWithdrawDelegatorReward{
+		DelegatorAddress: delegatorAddr.String(),
+		ValidatorAddress: validatorAddr,
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// WithdrawAllDelegatorRewards withdraws the rewards of all the delegations of the sender in one tx
+func (dc distributionClient) WithdrawAllDelegatorRewards(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	delegatorAddr, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	validators, err := dc.QueryDelegatorValidators(delegatorAddr.String())
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+	if len(validators) == 0 {
+		return sdk.ResultTx{}, sdk.Wrapf("%s has no delegation", delegatorAddr.String())
+	}
+
+	msgs := make([]sdk.Msg, len(validators))
+	for i, validator := range validators {
+		msgs[i] = &MsgWithdrawDelegatorReward{
+			DelegatorAddress: delegatorAddr.String(),
+			ValidatorAddress: validator,
+		}
+	}
+	return dc.BuildAndSend(msgs, baseTx)
+}
+
+// WithdrawValidatorCommission withdraws the commission of the validator operated by the sender
+func (dc distributionClient) WithdrawValidatorCommission(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	operator, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgWithdrawValidatorCommission{
+		ValidatorAddress: sdk.ValAddress(operator).String(),
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// SetWithdrawAddress sets the address the rewards of the sender are withdrawn to
+func (dc distributionClient) SetWithdrawAddress(withdrawAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	delegatorAddr, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgSetWithdrawAddress{
+		DelegatorAddress: delegatorAddr.String(),
+		WithdrawAddress:  withdrawAddr,
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (dc distributionClient) FundCommunityPool(amount sdk.DecCoins, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	depositor, err := dc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	coins, err := dc.ToMinCoin(amount...)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgFundCommunityPool{
+		Amount:    coins,
+		Depositor: depositor.String(),
+	}
+	return dc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (dc distributionClient) QueryDelegationRewards(delegatorAddr, validatorAddr string) (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegationRewards(
+		dc.Context(),
+		&QueryDelegationRewardsRequest{
+			DelegatorAddress: delegatorAddr,
+			ValidatorAddress: validatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Rewards, nil
+}
+
+func (dc distributionClient) QueryDelegationTotalRewards(delegatorAddr string) (QueryDelegationTotalRewardsResp, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return QueryDelegationTotalRewardsResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegationTotalRewards(
+		dc.Context(),
+		&QueryDelegationTotalRewardsRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return QueryDelegationTotalRewardsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryDelegationTotalRewardsResp), nil
+}
+
+// QueryDelegatorValidators returns the addresses of the validators the delegator is delegating to
+func (dc distributionClient) QueryDelegatorValidators(delegatorAddr string) ([]string, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegatorValidators(
+		dc.Context(),
+		&QueryDelegatorValidatorsRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Validators, nil
+}
+
+func (dc distributionClient) QueryWithdrawAddress(delegatorAddr string) (string, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return "", sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DelegatorWithdrawAddress(
+		dc.Context(),
+		&QueryDelegatorWithdrawAddressRequest{
+			DelegatorAddress: delegatorAddr,
+		},
+	)
+	if err != nil {
+		return "", sdk.Wrap(err)
+	}
+	return res.WithdrawAddress, nil
+}
+
+// QueryValidatorOutstandingRewards returns the rewards of the validator and its delegators not withdrawn yet
+func (dc distributionClient) QueryValidatorOutstandingRewards(validatorAddr string) (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).ValidatorOutstandingRewards(
+		dc.Context(),
+		&QueryValidatorOutstandingRewardsRequest{
+			ValidatorAddress: validatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Rewards.Rewards, nil
+}
+
+func (dc distributionClient) QueryValidatorCommission(validatorAddr string) (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).ValidatorCommission(
+		dc.Context(),
+		&QueryValidatorCommissionRequest{
+			ValidatorAddress: validatorAddr,
+		},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Commission.Commission, nil
+}
+
+func (dc distributionClient) QueryValidatorSlashes(request QueryValidatorSlashesReq) (QueryValidatorSlashesResp, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return QueryValidatorSlashesResp{}, sdk.Wrap(err)
+	}
+
+	endingHeight := request.EndingHeight
+	if endingHeight == 0 {
+		status, err := dc.Status(dc.Context())
+		if err != nil {
+			return QueryValidatorSlashesResp{}, sdk.Wrap(err)
+		}
+		endingHeight = uint64(status.SyncInfo.LatestBlockHeight)
+	}
+
+	offset, limit := utils.ParsePage(request.Page, request.Size)
+	res, err := NewQueryClient(conn).ValidatorSlashes(
+		dc.Context(),
+		&QueryValidatorSlashesRequest{
+			ValidatorAddress: request.ValidatorAddr,
+			StartingHeight:   request.StartingHeight,
+			EndingHeight:     endingHeight,
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryValidatorSlashesResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryValidatorSlashesResp), nil
+}
+
+func (dc distributionClient) QueryCommunityPool() (sdk.DecCoins, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).CommunityPool(
+		dc.Context(),
+		&QueryCommunityPoolRequest{},
+	)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return res.Pool, nil
+}
+
+func (dc distributionClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	conn, err := dc.GenConn()
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Params(
+		dc.Context(),
+		&QueryParamsRequest{},
+	)
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
@@ -0,0 +1,61 @@
+package distribution
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// WithdrawRewardsEvent is emitted for every validator the rewards are withdrawn from
+type WithdrawRewardsEvent struct {
+	Validator string `event:"validator"`
+	Amount    string `event:"amount"`
+}
+
+func (WithdrawRewardsEvent) EventType() string { return "withdraw_rewards" }
+
+type WithdrawCommissionEvent struct {
+	Amount string `event:"amount"`
+}
+
+func (WithdrawCommissionEvent) EventType() string { return "withdraw_commission" }
+
+type SetWithdrawAddressEvent struct {
+	WithdrawAddress string `event:"withdraw_address"`
+}
+
+func (SetWithdrawAddressEvent) EventType() string { return "set_withdraw_address" }
+
+// ProposerRewardEvent is emitted in the begin block for the proposer of the previous block
+type ProposerRewardEvent struct {
+	Validator string `event:"validator"`
+	Amount    string `event:"amount"`
+}
+
+func (ProposerRewardEvent) EventType() string { return "proposer_reward" }
+
+// CommissionEvent is emitted in the begin block for every validator earning commission
+type CommissionEvent struct {
+	Validator string `event:"validator"`
+	Amount    string `event:"amount"`
+}
+
+func (CommissionEvent) EventType() string { return "commission" }
+
+// RewardsEvent is emitted in the begin block for every validator earning rewards
+type RewardsEvent struct {
+	Validator string `event:"validator"`
+	Amount    string `event:"amount"`
+}
+
+func (RewardsEvent) EventType() string { return "rewards" }
+
+// RegisterEvents registers the typed events of the distribution module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		WithdrawRewardsEvent{},
+		WithdrawCommissionEvent{},
+		SetWithdrawAddressEvent{},
+		ProposerRewardEvent{},
+		CommissionEvent{},
+		RewardsEvent{},
+	)
+}
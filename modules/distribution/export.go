@@ -0,0 +1,66 @@
+package distribution
+
+import (
+	"context"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Distribution module api for user
+type Client interface {
+	sdk.Module
+	WithContext(ctx context.Context) Client
+
+	WithdrawDelegatorReward(validatorAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	WithdrawAllDelegatorRewards(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	WithdrawValidatorCommission(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	SetWithdrawAddress(withdrawAddr string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	FundCommunityPool(amount sdk.DecCoins, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QueryDelegationRewards(delegatorAddr, validatorAddr string) (sdk.DecCoins, sdk.Error)
+	QueryDelegationTotalRewards(delegatorAddr string) (QueryDelegationTotalRewardsResp, sdk.Error)
+	QueryDelegatorValidators(delegatorAddr string) ([]string, sdk.Error)
+	QueryWithdrawAddress(delegatorAddr string) (string, sdk.Error)
+	QueryValidatorOutstandingRewards(validatorAddr string) (sdk.DecCoins, sdk.Error)
+	QueryValidatorCommission(validatorAddr string) (sdk.DecCoins, sdk.Error)
+	QueryValidatorSlashes(request QueryValidatorSlashesReq) (QueryValidatorSlashesResp, sdk.Error)
+	QueryCommunityPool() (sdk.DecCoins, sdk.Error)
+	QueryParams() (QueryParamsResp, sdk.Error)
+}
+
+type DelegatorRewardResp struct {
+	ValidatorAddress string       `json:"validator_address"`
+	Reward           sdk.DecCoins `json:"reward"`
+}
+
+type QueryDelegationTotalRewardsResp struct {
+	Rewards []DelegatorRewardResp `json:"rewards"`
+	Total   sdk.DecCoins          `json:"total"`
+}
+
+// QueryValidatorSlashesReq queries the slashes of the validator between the heights,
+// EndingHeight being 0 for the latest height
+type QueryValidatorSlashesReq struct {
+	ValidatorAddr  string `json:"validator_address"`
+	StartingHeight uint64 `json:"starting_height"`
+	EndingHeight   uint64 `json:"ending_height"`
+	Page           uint64 `json:"page"`
+	Size           uint64 `json:"size"`
+}
+
+type ValidatorSlashEventResp struct {
+	ValidatorPeriod uint64  `json:"validator_period"`
+	Fraction        sdk.Dec `json:"fraction"`
+}
+
+type QueryValidatorSlashesResp struct {
+	Slashes []ValidatorSlashEventResp `json:"slashes"`
+	Total   uint64                    `json:"total"`
+}
+
+type QueryParamsResp struct {
+	CommunityTax        sdk.Dec `json:"community_tax"`
+	BaseProposerReward  sdk.Dec `json:"base_proposer_reward"`
+	BonusProposerReward sdk.Dec `json:"bonus_proposer_reward"`
+	WithdrawAddrEnabled bool    `json:"withdraw_addr_enabled"`
+}
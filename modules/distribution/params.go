@@ -0,0 +1,18 @@
+package distribution
+
+import yaml "gopkg.in/yaml.v2"
+
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func (vs ValidatorSlashEvents) String() string {
+	out, _ := yaml.Marshal(vs)
+	return string(out)
+}
+
+func (csp CommunityPoolSpendProposal) String() string {
+	out, _ := yaml.Marshal(csp)
+	return string(out)
+}
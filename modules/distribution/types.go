@@ -0,0 +1,158 @@
+package distribution
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "distribution"
+)
+
+var (
+	_ sdk.Msg = &MsgSetWithdrawAddress{}
+	_ sdk.Msg = &MsgWithdrawDelegatorReward{}
+	_ sdk.Msg = &MsgWithdrawValidatorCommission{}
+	_ sdk.Msg = &MsgFundCommunityPool{}
+)
+
+func (msg MsgSetWithdrawAddress) Route() string { return ModuleName }
+
+func (msg MsgSetWithdrawAddress) Type() string { return "set_withdraw_address" }
+
+func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgSetWithdrawAddress) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.DelegatorAddress); err != nil {
+		return sdk.Wrap(err)
+	}
+	if err := sdk.ValidateAccAddress(msg.WithdrawAddress); err != nil {
+		return sdk.WrapWithMessage(err, "invalid withdraw address")
+	}
+	return nil
+}
+
+func (msg MsgWithdrawDelegatorReward) Route() string { return ModuleName }
+
+func (msg MsgWithdrawDelegatorReward) Type() string { return "withdraw_delegator_reward" }
+
+func (msg MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
+	delegator, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delegator}
+}
+
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() error {
+	if err := sdk.ValidateAccAddress(msg.DelegatorAddress); err != nil {
+		return sdk.Wrap(err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdk.WrapWithMessage(err, "invalid validator address")
+	}
+	return nil
+}
+
+func (msg MsgWithdrawValidatorCommission) Route() string { return ModuleName }
+
+func (msg MsgWithdrawValidatorCommission) Type() string { return "withdraw_validator_commission" }
+
+func (msg MsgWithdrawValidatorCommission) GetSigners() []sdk.AccAddress {
+	validator, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(validator)}
+}
+
+func (msg MsgWithdrawValidatorCommission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgWithdrawValidatorCommission) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdk.WrapWithMessage(err, "invalid validator address")
+	}
+	return nil
+}
+
+func (msg MsgFundCommunityPool) Route() string { return ModuleName }
+
+func (msg MsgFundCommunityPool) Type() string { return "fund_community_pool" }
+
+func (msg MsgFundCommunityPool) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+func (msg MsgFundCommunityPool) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgFundCommunityPool) ValidateBasic() error {
+	if !msg.Amount.IsValid() {
+		return sdk.Wrapf("invalid amount: %s", msg.Amount)
+	}
+	if err := sdk.ValidateAccAddress(msg.Depositor); err != nil {
+		return sdk.Wrap(err)
+	}
+	return nil
+}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return QueryParamsResp{
+		CommunityTax:        q.Params.CommunityTax,
+		BaseProposerReward:  q.Params.BaseProposerReward,
+		BonusProposerReward: q.Params.BonusProposerReward,
+		WithdrawAddrEnabled: q.Params.WithdrawAddrEnabled,
+	}
+}
+
+func (q QueryDelegationTotalRewardsResponse) Convert() interface{} {
+	rewards := make([]DelegatorRewardResp, len(q.Rewards))
+	for i, r := range q.Rewards {
+		rewards[i] = DelegatorRewardResp{
+			ValidatorAddress: r.ValidatorAddress,
+			Reward:           r.Reward,
+		}
+	}
+	return QueryDelegationTotalRewardsResp{
+		Rewards: rewards,
+		Total:   q.Total,
+	}
+}
+
+func (q QueryValidatorSlashesResponse) Convert() interface{} {
+	slashes := make([]ValidatorSlashEventResp, len(q.Slashes))
+	for i, s := range q.Slashes {
+		slashes[i] = ValidatorSlashEventResp{
+			ValidatorPeriod: s.ValidatorPeriod,
+			Fraction:        s.Fraction,
+		}
+	}
+	return QueryValidatorSlashesResp{
+		Slashes: slashes,
+		Total:   q.Pagination.GetTotal(),
+	}
+}
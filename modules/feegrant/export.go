@@ -0,0 +1,30 @@
+package feegrant
+
+import (
+	"context"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Feegrant module api for user
+type Client interface {
+	sdk.Module
+	WithContext(ctx context.Context) Client
+
+	GrantAllowance(grantee string, allowance FeeAllowanceI, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+	RevokeAllowance(grantee string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QueryAllowance(granter, grantee string) (QueryAllowanceResp, sdk.Error)
+	QueryAllowances(grantee string, page, size uint64) (QueryAllowancesResp, sdk.Error)
+}
+
+type QueryAllowanceResp struct {
+	Granter   string        `json:"granter"`
+	Grantee   string        `json:"grantee"`
+	Allowance FeeAllowanceI `json:"allowance"`
+}
+
+type QueryAllowancesResp struct {
+	Allowances []QueryAllowanceResp `json:"allowances"`
+	Total      uint64               `json:"total"`
+}
@@ -0,0 +1,141 @@
+package feegrant
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type feegrantClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &feegrantClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (fc feegrantClient) Name() string {
+	return ModuleName
+}
+
+func (fc feegrantClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (fc feegrantClient) WithContext(ctx context.Context) Client {
+	return NewClient(fc.BaseClient.WithContext(ctx), fc.Marshaler)
+}
+
+// GrantAllowance lets the grantee pay its fees with the funds of the sender within the allowance, the
+// grantee then sets the sender as the FeeGranter of its BaseTx. An existing allowance must be revoked first.
+func (fc feegrantClient) GrantAllowance(grantee string, allowance FeeAllowanceI, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	granter, err := fc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg, e := NewMsgGrantAllowance(allowance, granter, granteeAddr)
+	if e != nil {
+		return sdk.ResultTx{}, sdk.Wrap(e)
+	}
+	return fc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// RevokeAllowance revokes the allowance of the sender to the grantee
+func (fc feegrantClient) RevokeAllowance(grantee string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	granter, err := fc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgRevokeAllowance{
+		Granter: granter.String(),
+		Grantee: grantee,
+	}
+	return fc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+func (fc feegrantClient) QueryAllowance(granter, grantee string) (QueryAllowanceResp, sdk.Error) {
+	conn, err := fc.GenConn()
+	if err != nil {
+		return QueryAllowanceResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Allowance(
+		fc.Context(),
+		&QueryAllowanceRequest{
+			Granter: granter,
+			Grantee: grantee,
+		},
+	)
+	if err != nil {
+		return QueryAllowanceResp{}, sdk.Wrap(err)
+	}
+	return fc.convertGrant(res.Allowance)
+}
+
+func (fc feegrantClient) QueryAllowances(grantee string, page, size uint64) (QueryAllowancesResp, sdk.Error) {
+	conn, err := fc.GenConn()
+	if err != nil {
+		return QueryAllowancesResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).Allowances(
+		fc.Context(),
+		&QueryAllowancesRequest{
+			Grantee: grantee,
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryAllowancesResp{}, sdk.Wrap(err)
+	}
+
+	allowances := make([]QueryAllowanceResp, len(res.Allowances))
+	for i, grant := range res.Allowances {
+		allowance, err := fc.convertGrant(grant)
+		if err != nil {
+			return QueryAllowancesResp{}, err
+		}
+		allowances[i] = allowance
+	}
+	return QueryAllowancesResp{
+		Allowances: allowances,
+		Total:      res.Pagination.GetTotal(),
+	}, nil
+}
+
+func (fc feegrantClient) convertGrant(grant *Grant) (QueryAllowanceResp, sdk.Error) {
+	if grant == nil {
+		return QueryAllowanceResp{}, sdk.Wrapf("allowance not found")
+	}
+
+	var allowance FeeAllowanceI
+	if err := fc.UnpackAny(grant.Allowance, &allowance); err != nil {
+		return QueryAllowanceResp{}, sdk.Wrap(err)
+	}
+	return QueryAllowanceResp{
+		Granter:   grant.Granter,
+		Grantee:   grant.Grantee,
+		Allowance: allowance,
+	}, nil
+}
@@ -0,0 +1,241 @@
+package feegrant
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "feegrant"
+)
+
+var (
+	_ sdk.Msg = &MsgGrantAllowance{}
+	_ sdk.Msg = &MsgRevokeAllowance{}
+
+	_ types.UnpackInterfacesMessage = MsgGrantAllowance{}
+	_ types.UnpackInterfacesMessage = Grant{}
+	_ types.UnpackInterfacesMessage = AllowedMsgAllowance{}
+
+	_ FeeAllowanceI = &BasicAllowance{}
+	_ FeeAllowanceI = &PeriodicAllowance{}
+	_ FeeAllowanceI = &AllowedMsgAllowance{}
+)
+
+// FeeAllowanceI lets the grantee pay the fees of its txs with the funds of the granter,
+// the granter being set as the fee granter of the tx
+type FeeAllowanceI interface {
+	proto.Message
+
+	ValidateBasic() error
+}
+
+// NewBasicAllowance returns an allowance of at most spendLimit in total, unlimited if spendLimit is empty,
+// which never expires if expiration is nil
+func NewBasicAllowance(spendLimit sdk.Coins, expiration *time.Time) *BasicAllowance {
+	return &BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+func (a BasicAllowance) ValidateBasic() error {
+	if a.SpendLimit != nil {
+		if !a.SpendLimit.IsValid() {
+			return fmt.Errorf("spend limit is invalid: %s", a.SpendLimit)
+		}
+		if !a.SpendLimit.IsAllPositive() {
+			return errors.New("spend limit must be positive")
+		}
+	}
+	if a.Expiration != nil && a.Expiration.Unix() < 0 {
+		return errors.New("expiration time cannot be negative")
+	}
+	return nil
+}
+
+// NewPeriodicAllowance returns an allowance of at most periodSpendLimit per period within the limits of basic,
+// the first period starting now
+func NewPeriodicAllowance(basic BasicAllowance, period time.Duration, periodSpendLimit sdk.Coins) *PeriodicAllowance {
+	return &PeriodicAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      time.Now().Add(period),
+	}
+}
+
+func (a PeriodicAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+	if !a.PeriodSpendLimit.IsValid() {
+		return fmt.Errorf("period spend limit is invalid: %s", a.PeriodSpendLimit)
+	}
+	if !a.PeriodSpendLimit.IsAllPositive() {
+		return errors.New("period spend limit must be positive")
+	}
+	if !a.PeriodCanSpend.IsValid() {
+		return fmt.Errorf("period can spend is invalid: %s", a.PeriodCanSpend)
+	}
+	// ensure PeriodSpendLimit can be subtracted from the spend limit (same coin types)
+	if a.Basic.SpendLimit != nil && !a.PeriodSpendLimit.DenomsSubsetOf(a.Basic.SpendLimit) {
+		return errors.New("period spend limit has different currency than basic spend limit")
+	}
+	if a.Period < 0 {
+		return errors.New("period cannot be negative")
+	}
+	return nil
+}
+
+// NewAllowedMsgAllowance restricts the allowance to the msgs of the type urls, e.g. /cosmos.gov.v1beta1.MsgVote
+func NewAllowedMsgAllowance(allowance FeeAllowanceI, allowedMsgs []string) (*AllowedMsgAllowance, error) {
+	any, err := types.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowedMsgAllowance{
+		Allowance:       any,
+		AllowedMessages: allowedMsgs,
+	}, nil
+}
+
+// GetFeeAllowanceI returns the restricted allowance unpacked by the codec, nil if it was not
+func (a AllowedMsgAllowance) GetFeeAllowanceI() FeeAllowanceI {
+	allowance, ok := a.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+	return allowance
+}
+
+func (a AllowedMsgAllowance) ValidateBasic() error {
+	if len(a.AllowedMessages) == 0 {
+		return errors.New("allowed messages can not be empty")
+	}
+	allowance := a.GetFeeAllowanceI()
+	if allowance == nil {
+		return errors.New("allowance can not be empty")
+	}
+	return allowance.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a AllowedMsgAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetFeeAllowanceI returns the allowance unpacked by the codec, nil if it was not
+func (g Grant) GetFeeAllowanceI() FeeAllowanceI {
+	allowance, ok := g.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+	return allowance
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (g Grant) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(g.Allowance, &allowance)
+}
+
+// NewMsgGrantAllowance returns a msg granting the allowance of the granter to the grantee
+func NewMsgGrantAllowance(allowance FeeAllowanceI, granter, grantee sdk.AccAddress) (*MsgGrantAllowance, error) {
+	any, err := types.NewAnyWithValue(allowance)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgGrantAllowance{
+		Granter:   granter.String(),
+		Grantee:   grantee.String(),
+		Allowance: any,
+	}, nil
+}
+
+// GetFeeAllowanceI returns the allowance unpacked by the codec, nil if it was not
+func (msg MsgGrantAllowance) GetFeeAllowanceI() FeeAllowanceI {
+	allowance, ok := msg.Allowance.GetCachedValue().(FeeAllowanceI)
+	if !ok {
+		return nil
+	}
+	return allowance
+}
+
+func (msg MsgGrantAllowance) Route() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgGrantAllowance) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgGrantAllowance) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgGrantAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgGrantAllowance) ValidateBasic() error {
+	if err := validateGranterGrantee(msg.Granter, msg.Grantee); err != nil {
+		return err
+	}
+	allowance := msg.GetFeeAllowanceI()
+	if allowance == nil {
+		return errors.New("allowance can not be empty")
+	}
+	return allowance.ValidateBasic()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgGrantAllowance) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var allowance FeeAllowanceI
+	return unpacker.UnpackAny(msg.Allowance, &allowance)
+}
+
+func (msg MsgRevokeAllowance) Route() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgRevokeAllowance) Type() string { return sdk.MsgTypeURL(&msg) }
+
+func (msg MsgRevokeAllowance) GetSigners() []sdk.AccAddress {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{granter}
+}
+
+func (msg MsgRevokeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeAllowance) ValidateBasic() error {
+	return validateGranterGrantee(msg.Granter, msg.Grantee)
+}
+
+func validateGranterGrantee(granter, grantee string) error {
+	granterAddr, err := sdk.AccAddressFromBech32(granter)
+	if err != nil {
+		return sdk.WrapWithMessage(err, "invalid granter address")
+	}
+	granteeAddr, err := sdk.AccAddressFromBech32(grantee)
+	if err != nil {
+		return sdk.WrapWithMessage(err, "invalid grantee address")
+	}
+	if granterAddr.Equals(granteeAddr) {
+		return errors.New("granter and grantee can not be the same")
+	}
+	return nil
+}
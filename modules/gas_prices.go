@@ -0,0 +1,75 @@
+package modules
+
+import (
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcnode "github.com/irisnet/irishub-sdk-go/client/grpc/node"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// minGasPrices caches the minimum gas prices of the node, they are part of the node configuration
+// and don't change while it runs. The failed queries are not cached.
+type minGasPrices struct {
+	mu      sync.Mutex
+	queried bool
+	prices  sdk.DecCoins
+}
+
+// QueryMinGasPrices returns the minimum gas prices configured on the node. The nodes built with
+// cosmos-sdk before v0.46 don't serve the node configuration, the GasPrices of the ClientConfig
+// are returned for them.
+func (base *baseClient) QueryMinGasPrices() (sdk.DecCoins, sdk.Error) {
+	base.minGasPrices.mu.Lock()
+	queried, prices := base.minGasPrices.queried, base.minGasPrices.prices
+	base.minGasPrices.mu.Unlock()
+	if queried {
+		return prices, nil
+	}
+
+	conn, err := base.GenConn()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	res, err := grpcnode.NewServiceClient(conn).Config(base.Context(), &grpcnode.ConfigRequest{})
+	switch {
+	case status.Code(err) == codes.Unimplemented:
+		base.Logger().Debug("the node doesn't report its minimum gas prices, using the configured gas prices")
+		prices = base.cfg.GasPrices
+	case err != nil:
+		return nil, sdk.Wrap(err)
+	default:
+		if prices, err = parseMinGasPrices(res.MinimumGasPrice); err != nil {
+			return nil, sdk.Wrap(err)
+		}
+	}
+
+	base.minGasPrices.mu.Lock()
+	base.minGasPrices.queried = true
+	base.minGasPrices.prices = prices
+	base.minGasPrices.mu.Unlock()
+	return prices, nil
+}
+
+// parseMinGasPrices parses the minimum gas prices reported by the node, the nodes without minimum
+// gas prices report them as zero
+func parseMinGasPrices(minGasPrices string) (sdk.DecCoins, error) {
+	var prices sdk.DecCoins
+	for _, coinStr := range strings.Split(minGasPrices, ",") {
+		if len(strings.TrimSpace(coinStr)) == 0 {
+			continue
+		}
+		coin, err := sdk.ParseDecCoin(coinStr)
+		if err != nil {
+			return nil, sdk.Wrapf("invalid minimum gas prices %s: %s", minGasPrices, err.Error())
+		}
+		if coin.IsPositive() {
+			prices = append(prices, coin)
+		}
+	}
+	return prices.Sort(), nil
+}
@@ -0,0 +1,66 @@
+package modules
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	grpcnode "github.com/irisnet/irishub-sdk-go/client/grpc/node"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type nodeService struct {
+	grpcnode.UnimplementedServiceServer
+	calls int
+}
+
+func (s *nodeService) Config(context.Context, *grpcnode.ConfigRequest) (*grpcnode.ConfigResponse, error) {
+	s.calls++
+	return &grpcnode.ConfigResponse{MinimumGasPrice: "0.000000000000000000stake,0.200000000000000000uiris"}, nil
+}
+
+func newGasPricesClient(t *testing.T, service *nodeService) *baseClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	if service != nil {
+		grpcnode.RegisterServiceServer(s, service)
+	}
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	gasPrices, err := sdk.ParseDecCoins("0.5uiris")
+	require.NoError(t, err)
+	return &baseClient{
+		ctx: context.Background(),
+		GRPCClient: NewGRPCClient("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		})),
+		cfg:          &sdk.ClientConfig{GasPrices: gasPrices},
+		logger:       log.NewNopLogger(),
+		minGasPrices: &minGasPrices{},
+	}
+}
+
+func TestQueryMinGasPrices(t *testing.T) {
+	service := &nodeService{}
+	base := newGasPricesClient(t, service)
+
+	// the zero prices are dropped and the prices are queried once
+	for i := 0; i < 2; i++ {
+		prices, err := base.QueryMinGasPrices()
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("uiris", sdk.NewDecWithPrec(2, 1))), prices)
+	}
+	require.Equal(t, 1, service.calls)
+
+	// the nodes not serving their configuration fall back to the configured gas prices
+	base = newGasPricesClient(t, nil)
+	prices, err := base.QueryMinGasPrices()
+	require.NoError(t, err)
+	require.Equal(t, base.cfg.GasPrices, prices)
+}
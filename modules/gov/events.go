@@ -0,0 +1,54 @@
+package gov
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type SubmitProposalEvent struct {
+	ProposalID        uint64 `event:"proposal_id,required"`
+	ProposalType      string `event:"proposal_type"`
+	VotingPeriodStart uint64 `event:"voting_period_start"`
+}
+
+func (SubmitProposalEvent) EventType() string { return sdk.EventTypeSubmitProposal }
+
+type ProposalDepositEvent struct {
+	ProposalID uint64    `event:"proposal_id"`
+	Amount     sdk.Coins `event:"amount"`
+}
+
+func (ProposalDepositEvent) EventType() string { return "proposal_deposit" }
+
+type ProposalVoteEvent struct {
+	ProposalID uint64 `event:"proposal_id"`
+	Option     string `event:"option"`
+}
+
+func (ProposalVoteEvent) EventType() string { return "proposal_vote" }
+
+// ActiveProposalEvent is emitted in the end block once the voting period of a proposal ends
+type ActiveProposalEvent struct {
+	ProposalID     uint64 `event:"proposal_id"`
+	ProposalResult string `event:"proposal_result"`
+}
+
+func (ActiveProposalEvent) EventType() string { return "active_proposal" }
+
+// InactiveProposalEvent is emitted in the end block once the deposit period of a proposal ends
+type InactiveProposalEvent struct {
+	ProposalID     uint64 `event:"proposal_id"`
+	ProposalResult string `event:"proposal_result"`
+}
+
+func (InactiveProposalEvent) EventType() string { return "inactive_proposal" }
+
+// RegisterEvents registers the typed events of the gov module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		SubmitProposalEvent{},
+		ProposalDepositEvent{},
+		ProposalVoteEvent{},
+		ActiveProposalEvent{},
+		InactiveProposalEvent{},
+	)
+}
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"context"
+
 	"time"
 
 	sdk "github.com/irisnet/irishub-sdk-go/types"
@@ -9,6 +11,7 @@ import (
 // expose Gov module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 	SubmitProposal(request SubmitProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error)
 	Deposit(request DepositRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	Vote(request VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
@@ -21,6 +24,11 @@ type Client interface {
 	QueryDeposit(proposalId uint64, depositor string) (QueryDepositResp, sdk.Error)
 	QueryDeposits(proposalId uint64) ([]QueryDepositResp, sdk.Error)
 	QueryTallyResult(proposalId uint64) (QueryTallyResultResp, sdk.Error)
+	IterateProposals(proposalStatus string, opts sdk.PageOptions, callback func(QueryProposalResp) bool) sdk.Error
+	IterateVotes(proposalId uint64, opts sdk.PageOptions, callback func(QueryVoteResp) bool) sdk.Error
+	IterateDeposits(proposalId uint64, opts sdk.PageOptions, callback func(QueryDepositResp) bool) sdk.Error
+	SubscribeVoteTx(proposalID uint64, voter string, callback EventMsgVoteCallback) (sdk.Subscription, sdk.Error)
+	SubscribeDepositTx(proposalID uint64, depositor string, callback EventMsgDepositCallback) (sdk.Subscription, sdk.Error)
 }
 
 type SubmitProposalRequest struct {
@@ -90,3 +98,7 @@ type QueryTallyResultResp struct {
 	No         sdk.Int `json:"no"`
 	NoWithVeto sdk.Int `json:"no_with_veto"`
 }
+
+type EventMsgVoteCallback func(msg *MsgVote, data sdk.EventDataMsg)
+
+type EventMsgDepositCallback func(msg *MsgDeposit, data sdk.EventDataMsg)
@@ -2,7 +2,6 @@ package gov
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
 	"github.com/irisnet/irishub-sdk-go/codec/types"
@@ -30,6 +29,15 @@ func (gc govClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 	RegisterInterfaces(registry)
 }
 
+func (gc govClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (gc govClient) WithContext(ctx context.Context) Client {
+	return NewClient(gc.BaseClient.WithContext(ctx), gc.Marshaler)
+}
+
 func (gc govClient) SubmitProposal(request SubmitProposalRequest, baseTx sdk.BaseTx) (uint64, sdk.ResultTx, sdk.Error) {
 	proposer, err := gc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
@@ -52,16 +60,11 @@ func (gc govClient) SubmitProposal(request SubmitProposalRequest, baseTx sdk.Bas
 		return 0, sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
-	proposalIdStr, e := result.Events.GetValue(sdk.EventTypeSubmitProposal, AttributeKeyProposalId)
-	if e != nil {
+	var event SubmitProposalEvent
+	if e := sdk.DecodeEvent(result.Events, &event); e != nil {
 		return 0, result, sdk.Wrap(e)
 	}
-
-	proposalId, e := strconv.Atoi(proposalIdStr)
-	if e != nil {
-		return 0, result, sdk.Wrap(e)
-	}
-	return uint64(proposalId), result, err
+	return event.ProposalID, result, err
 }
 
 func (gc govClient) Deposit(request DepositRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
@@ -101,13 +104,12 @@ func (gc govClient) Vote(request VoteRequest, baseTx sdk.BaseTx) (sdk.ResultTx,
 
 func (gc govClient) QueryProposal(proposalId uint64) (QueryProposalResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryProposalResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Proposal(
-		context.Background(),
+		gc.Context(),
 		&QueryProposalRequest{
 			ProposalId: proposalId,
 		})
@@ -121,13 +123,12 @@ func (gc govClient) QueryProposal(proposalId uint64) (QueryProposalResp, sdk.Err
 // about proposalStatus see VoteOption_value
 func (gc govClient) QueryProposals(proposalStatus string) ([]QueryProposalResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Proposals(
-		context.Background(),
+		gc.Context(),
 		&QueryProposalsRequest{
 			ProposalStatus: ProposalStatus(VoteOption_value[proposalStatus]),
 			Pagination: &query.PageRequest{
@@ -145,13 +146,12 @@ func (gc govClient) QueryProposals(proposalStatus string) ([]QueryProposalResp,
 // about QueryVoteResp.Option see VoteOption_name
 func (gc govClient) QueryVote(proposalId uint64, voter string) (QueryVoteResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryVoteResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Vote(
-		context.Background(),
+		gc.Context(),
 		&QueryVoteRequest{
 			ProposalId: proposalId,
 			Voter:      voter,
@@ -164,13 +164,12 @@ func (gc govClient) QueryVote(proposalId uint64, voter string) (QueryVoteResp, s
 
 func (gc govClient) QueryVotes(proposalId uint64) ([]QueryVoteResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Votes(
-		context.Background(),
+		gc.Context(),
 		&QueryVotesRequest{
 			ProposalId: proposalId,
 			Pagination: &query.PageRequest{
@@ -188,13 +187,12 @@ func (gc govClient) QueryVotes(proposalId uint64) ([]QueryVoteResp, sdk.Error) {
 // QueryParams params_type("voting", "tallying", "deposit"), if don't pass will return all params_typ res
 func (gc govClient) QueryParams(paramsType string) (QueryParamsResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Params(
-		context.Background(),
+		gc.Context(),
 		&QueryParamsRequest{
 			ParamsType: paramsType,
 		},
@@ -207,13 +205,12 @@ func (gc govClient) QueryParams(paramsType string) (QueryParamsResp, sdk.Error)
 
 func (gc govClient) QueryDeposit(proposalId uint64, depositor string) (QueryDepositResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryDepositResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Deposit(
-		context.Background(),
+		gc.Context(),
 		&QueryDepositRequest{
 			ProposalId: proposalId,
 			Depositor:  depositor,
@@ -227,13 +224,12 @@ func (gc govClient) QueryDeposit(proposalId uint64, depositor string) (QueryDepo
 
 func (gc govClient) QueryDeposits(proposalId uint64) ([]QueryDepositResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Deposits(
-		context.Background(),
+		gc.Context(),
 		&QueryDepositsRequest{
 			ProposalId: proposalId,
 			Pagination: &query.PageRequest{
@@ -251,13 +247,12 @@ func (gc govClient) QueryDeposits(proposalId uint64) ([]QueryDepositResp, sdk.Er
 
 func (gc govClient) QueryTallyResult(proposalId uint64) (QueryTallyResultResp, sdk.Error) {
 	conn, err := gc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryTallyResultResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).TallyResult(
-		context.Background(),
+		gc.Context(),
 		&QueryTallyResultRequest{
 			ProposalId: proposalId,
 		},
@@ -267,3 +262,132 @@ func (gc govClient) QueryTallyResult(proposalId uint64) (QueryTallyResultResp, s
 	}
 	return res.Tally.Convert().(QueryTallyResultResp), nil
 }
+
+// IterateProposals calls the callback with the proposals of the status (all of them when status is "")
+// page by page, until it returns false or the proposals are exhausted. About proposalStatus see ProposalStatus_value
+func (gc govClient) IterateProposals(proposalStatus string, opts sdk.PageOptions, callback func(QueryProposalResp) bool) sdk.Error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := gc.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res, err := NewQueryClient(conn).Proposals(
+			gc.Context(),
+			&QueryProposalsRequest{
+				ProposalStatus: ProposalStatus(ProposalStatus_value[proposalStatus]),
+				Pagination:     &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(res.Proposals))
+		for i, p := range res.Proposals {
+			items[i] = p.Convert()
+		}
+		return items, res.GetPagination().GetNextKey(), nil
+	}, opts)
+	return it.Each(func(item interface{}) bool {
+		return callback(item.(QueryProposalResp))
+	})
+}
+
+// IterateVotes calls the callback with the votes of the proposal page by page, until it returns false
+// or the votes are exhausted
+func (gc govClient) IterateVotes(proposalId uint64, opts sdk.PageOptions, callback func(QueryVoteResp) bool) sdk.Error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := gc.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res, err := NewQueryClient(conn).Votes(
+			gc.Context(),
+			&QueryVotesRequest{
+				ProposalId: proposalId,
+				Pagination: &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(res.Votes))
+		for i, v := range res.Votes {
+			items[i] = v.Convert()
+		}
+		return items, res.GetPagination().GetNextKey(), nil
+	}, opts)
+	return it.Each(func(item interface{}) bool {
+		return callback(item.(QueryVoteResp))
+	})
+}
+
+// IterateDeposits calls the callback with the deposits of the proposal page by page, until it returns
+// false or the deposits are exhausted
+func (gc govClient) IterateDeposits(proposalId uint64, opts sdk.PageOptions, callback func(QueryDepositResp) bool) sdk.Error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := gc.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res, err := NewQueryClient(conn).Deposits(
+			gc.Context(),
+			&QueryDepositsRequest{
+				ProposalId: proposalId,
+				Pagination: &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(res.Deposits))
+		for i, d := range res.Deposits {
+			items[i] = d.Convert()
+		}
+		return items, res.GetPagination().GetNextKey(), nil
+	}, opts)
+	return it.Each(func(item interface{}) bool {
+		return callback(item.(QueryDepositResp))
+	})
+}
+
+// SubscribeVoteTx subscribes the votes of the voter on the proposal, 0 or an empty voter matches any
+func (gc govClient) SubscribeVoteTx(proposalID uint64, voter string, callback EventMsgVoteCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgVote{}.Type())
+	if proposalID > 0 {
+		builder.AddCondition(sdk.NewCond("proposal_vote", AttributeKeyProposalId).EQ(sdk.EventValue(proposalID)))
+	}
+	if len(voter) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(voter)))
+	}
+	return sdk.SubscribeMsgs(gc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgVote)
+		if !ok || (proposalID > 0 && msg.ProposalId != proposalID) || (len(voter) > 0 && msg.Voter != voter) {
+			return
+		}
+		callback(msg, data)
+	})
+}
+
+// SubscribeDepositTx subscribes the deposits of the depositor on the proposal, 0 or an empty depositor matches any
+func (gc govClient) SubscribeDepositTx(proposalID uint64, depositor string, callback EventMsgDepositCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgDeposit{}.Type())
+	if proposalID > 0 {
+		builder.AddCondition(sdk.NewCond("proposal_deposit", AttributeKeyProposalId).EQ(sdk.EventValue(proposalID)))
+	}
+	if len(depositor) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(depositor)))
+	}
+	return sdk.SubscribeMsgs(gc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgDeposit)
+		if !ok || (proposalID > 0 && msg.ProposalId != proposalID) || (len(depositor) > 0 && msg.Depositor != depositor) {
+			return
+		}
+		callback(msg, data)
+	})
+}
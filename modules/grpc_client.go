@@ -1,17 +1,156 @@
 package modules
 
 import (
+	"context"
+	"sync"
+
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
+// balancedScheme is the scheme of the resolver of the addresses of a balanced client
+const balancedScheme = "irishub"
+
 type grpcClient struct {
-	url string
+	url  string
+	opts []grpc.DialOption
+
+	// the addresses resolved for a balanced client
+	endpoints []string
+	resolver  *manual.Resolver
+
+	mu   sync.Mutex
+	conn *grpc.ClientConn
+}
+
+func NewGRPCClient(url string, opts ...grpc.DialOption) *grpcClient {
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+	return &grpcClient{url: url, opts: opts}
+}
+
+// NewBalancedGRPCClient returns a client whose connection is balanced round robin among the
+// addresses, the calls are sent to the addresses the connection is ready on.
+func NewBalancedGRPCClient(addrs []string, opts ...grpc.DialOption) *grpcClient {
+	if len(addrs) == 1 {
+		return NewGRPCClient(addrs[0], opts...)
+	}
+	if len(opts) == 0 {
+		opts = []grpc.DialOption{grpc.WithInsecure()}
+	}
+
+	r := manual.NewBuilderWithScheme(balancedScheme)
+	r.InitialState(resolverState(addrs))
+	return &grpcClient{
+		url: balancedScheme + ":///nodes",
+		opts: append([]grpc.DialOption{
+			grpc.WithResolvers(r),
+			grpc.WithDefaultServiceConfig(`{"loadBalancingConfig":[{"round_robin":{}}]}`),
+		}, opts...),
+		endpoints: addrs,
+		resolver:  r,
+	}
+}
+
+// setEndpoints restricts the connection of a balanced client to the addresses,
+// all the addresses are used again if none is given
+func (g *grpcClient) setEndpoints(addrs []string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.resolver == nil {
+		return
+	}
+	if len(addrs) == 0 {
+		addrs = g.endpoints
+	}
+
+	state := resolverState(addrs)
+	g.resolver.InitialState(state)
+	if g.conn != nil {
+		g.resolver.UpdateState(state)
+	}
+}
+
+func resolverState(addrs []string) resolver.State {
+	state := resolver.State{Addresses: make([]resolver.Address, len(addrs))}
+	for i, addr := range addrs {
+		state.Addresses[i] = resolver.Address{Addr: addr}
+	}
+	return state
+}
+
+// GenConn returns the connection shared by all the queries. It is dialed on the first call
+// and reconnects by itself when the transport breaks, so the callers must not close it.
+func (g *grpcClient) GenConn() (*grpc.ClientConn, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.conn != nil && g.conn.GetState() != connectivity.Shutdown {
+		return g.conn, nil
+	}
+
+	conn, err := grpc.Dial(g.url, g.opts...)
+	if err != nil {
+		return nil, err
+	}
+	g.conn = conn
+	return conn, nil
 }
 
-func NewGRPCClient(url string) grpcClient {
-	return grpcClient{url: url}
+// Close closes the shared connection, the next GenConn dials a new one.
+func (g *grpcClient) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.conn == nil {
+		return nil
+	}
+	err := g.conn.Close()
+	g.conn = nil
+	return err
+}
+
+// grpcDialOptions returns the dial options of the shared connection from the config
+func grpcDialOptions(cfg sdk.ClientConfig) []grpc.DialOption {
+	var opts []grpc.DialOption
+	if cfg.TLSConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(cfg.TLSConfig)))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	opts = append(opts, grpc.WithKeepaliveParams(cfg.KeepAlive))
+
+	interceptors := cfg.UnaryInterceptors
+	if len(cfg.Metadata) > 0 {
+		md := metadata.New(cfg.Metadata)
+		interceptors = append([]grpc.UnaryClientInterceptor{metadataInterceptor(md)}, interceptors...)
+	}
+	if len(interceptors) > 0 {
+		opts = append(opts, grpc.WithChainUnaryInterceptor(interceptors...))
+	}
+
+	// the custom options are applied last so that they can override the ones above
+	return append(opts, cfg.GRPCDialOptions...)
 }
 
-func (g grpcClient) GenConn() (*grpc.ClientConn, error) {
-	return grpc.Dial(g.url, grpc.WithInsecure())
+// metadataInterceptor attaches the metadata to the outgoing context of every call
+func metadataInterceptor(md metadata.MD) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for k, vs := range md {
+			for _, v := range vs {
+				ctx = metadata.AppendToOutgoingContext(ctx, k, v)
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
 }
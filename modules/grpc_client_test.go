@@ -0,0 +1,121 @@
+package modules
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
+)
+
+func TestGRPCClient(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	var received metadata.MD
+	s := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		received, _ = metadata.FromIncomingContext(ctx)
+		return handler(ctx, req)
+	}))
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	go func() { _ = s.Serve(lis) }()
+	defer s.Stop()
+
+	var intercepted []string
+	cfg, err := sdk.NewClientConfig("tcp://localhost:26657", "bufnet", "irishub",
+		sdk.KeyDAOOption(store.NewMemory(nil)),
+		sdk.MetadataOption(map[string]string{"authorization": "Bearer token"}),
+		sdk.UnaryInterceptorOption(func(ctx context.Context, method string, req, reply interface{},
+			cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			intercepted = append(intercepted, method)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}),
+		sdk.GRPCDialOption(grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		})),
+	)
+	require.NoError(t, err)
+
+	client := NewGRPCClient(cfg.GRPCAddr, grpcDialOptions(cfg)...)
+	conn, err := client.GenConn()
+	require.NoError(t, err)
+
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Bearer token"}, received.Get("authorization"))
+	require.Equal(t, []string{"/grpc.health.v1.Health/Check"}, intercepted)
+
+	// the connection is shared by the queries
+	shared, err := client.GenConn()
+	require.NoError(t, err)
+	require.Same(t, conn, shared)
+
+	// a closed connection is dialed again
+	require.NoError(t, client.Close())
+	conn, err = client.GenConn()
+	require.NoError(t, err)
+	require.NotSame(t, shared, conn)
+	require.NoError(t, client.Close())
+}
+
+func TestBalancedGRPCClient(t *testing.T) {
+	served := make(map[string]int)
+	listeners := make(map[string]*bufconn.Listener)
+	var mu sync.Mutex
+	for _, addr := range []string{"a", "b"} {
+		addr, lis := addr, bufconn.Listen(1024*1024)
+		listeners[addr] = lis
+		s := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			mu.Lock()
+			served[addr]++
+			mu.Unlock()
+			return handler(ctx, req)
+		}))
+		healthpb.RegisterHealthServer(s, health.NewServer())
+		go func() { _ = s.Serve(lis) }()
+		defer s.Stop()
+	}
+
+	client := NewBalancedGRPCClient([]string{"a", "b"}, grpc.WithInsecure(),
+		grpc.WithContextDialer(func(_ context.Context, addr string) (net.Conn, error) {
+			return listeners[addr].Dial()
+		}))
+	defer func() { _ = client.Close() }()
+
+	conn, err := client.GenConn()
+	require.NoError(t, err)
+	check := func() {
+		_, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+	}
+
+	// the calls are balanced among the addresses
+	require.Eventually(t, func() bool {
+		check()
+		mu.Lock()
+		defer mu.Unlock()
+		return served["a"] > 0 && served["b"] > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the calls only reach the remaining address
+	client.setEndpoints([]string{"b"})
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		before := served["a"]
+		mu.Unlock()
+		for i := 0; i < 10; i++ {
+			check()
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return served["a"] == before
+	}, 5*time.Second, 10*time.Millisecond)
+}
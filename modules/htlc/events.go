@@ -0,0 +1,37 @@
+package htlc
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type CreateHTLCEvent struct {
+	ID                   string `event:"id"`
+	Sender               string `event:"sender"`
+	Receiver             string `event:"receiver"`
+	ReceiverOnOtherChain string `event:"receiver_on_other_chain"`
+	SenderOnOtherChain   string `event:"sender_on_other_chain"`
+	Transfer             bool   `event:"transfer"`
+}
+
+func (CreateHTLCEvent) EventType() string { return "create_htlc" }
+
+type ClaimHTLCEvent struct {
+	ID       string `event:"id"`
+	Sender   string `event:"sender"`
+	Secret   string `event:"secret"`
+	Transfer bool   `event:"transfer"`
+}
+
+func (ClaimHTLCEvent) EventType() string { return "claim_htlc" }
+
+// RefundHTLCEvent is emitted in the begin block once an HTLC expires
+type RefundHTLCEvent struct {
+	ID string `event:"id"`
+}
+
+func (RefundHTLCEvent) EventType() string { return "refund_htlc" }
+
+// RegisterEvents registers the typed events of the htlc module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(CreateHTLCEvent{}, ClaimHTLCEvent{}, RefundHTLCEvent{})
+}
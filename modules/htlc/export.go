@@ -1,16 +1,23 @@
 package htlc
 
-import sdk "github.com/irisnet/irishub-sdk-go/types"
+import (
+	"context"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
 
 // expose HTLC module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	CreateHTLC(request CreateHTLCRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	ClaimHTLC(hashLock string, secret string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 
 	QueryHTLC(hashLock string) (QueryHTLCResp, sdk.Error)
 	QueryParams() (QueryParamsResp, sdk.Error)
+	SubscribeCreateHTLCTx(sender, to string, callback EventMsgCreateHTLCCallback) (sdk.Subscription, sdk.Error)
+	SubscribeClaimHTLCTx(id string, callback EventMsgClaimHTLCCallback) (sdk.Subscription, sdk.Error)
 }
 
 type CreateHTLCRequest struct {
@@ -59,3 +66,7 @@ type SupplyLimitDto struct {
 	TimePeriod     int64  `json:"time_period"`
 	TimeBasedLimit uint64 `json:"time_based_limit"`
 }
+
+type EventMsgCreateHTLCCallback func(msg *MsgCreateHTLC, data sdk.EventDataMsg)
+
+type EventMsgClaimHTLCCallback func(msg *MsgClaimHTLC, data sdk.EventDataMsg)
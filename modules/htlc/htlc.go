@@ -28,6 +28,15 @@ func (hc htlcClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 	RegisterInterfaces(registry)
 }
 
+func (hc htlcClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (hc htlcClient) WithContext(ctx context.Context) Client {
+	return NewClient(hc.BaseClient.WithContext(ctx), hc.Marshaler)
+}
+
 func (hc htlcClient) CreateHTLC(request CreateHTLCRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	sender, err := hc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
@@ -77,13 +86,12 @@ func (hc htlcClient) QueryHTLC(hashLockId string) (QueryHTLCResp, sdk.Error) {
 	}
 
 	conn, err := hc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryHTLCResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).HTLC(
-		context.Background(),
+		hc.Context(),
 		&QueryHTLCRequest{
 			Id: hashLockId,
 		})
@@ -96,16 +104,42 @@ func (hc htlcClient) QueryHTLC(hashLockId string) (QueryHTLCResp, sdk.Error) {
 func (hc htlcClient) QueryParams() (QueryParamsResp, sdk.Error) {
 
 	conn, err := hc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Params(
-		context.Background(),
+		hc.Context(),
 		&QueryParamsRequest{})
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
 	return res.Params.Convert().(QueryParamsResp), nil
 }
+
+// SubscribeCreateHTLCTx subscribes the HTLCs created by the sender to the recipient, an empty address matches any
+func (hc htlcClient) SubscribeCreateHTLCTx(sender, to string, callback EventMsgCreateHTLCCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgCreateHTLC{}.Type())
+	if len(sender) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(sender)))
+	}
+	return sdk.SubscribeMsgs(hc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgCreateHTLC)
+		if !ok || (len(sender) > 0 && msg.Sender != sender) || (len(to) > 0 && msg.To != to) {
+			return
+		}
+		callback(msg, data)
+	})
+}
+
+// SubscribeClaimHTLCTx subscribes the claims of the HTLC, an empty id matches any
+func (hc htlcClient) SubscribeClaimHTLCTx(id string, callback EventMsgClaimHTLCCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgClaimHTLC{}.Type())
+	return sdk.SubscribeMsgs(hc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgClaimHTLC)
+		if !ok || (len(id) > 0 && msg.Id != id) {
+			return
+		}
+		callback(msg, data)
+	})
+}
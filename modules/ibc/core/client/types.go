@@ -0,0 +1,47 @@
+package client
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+)
+
+// ClientState is the state of a light client tracking a counterparty chain
+type ClientState interface {
+	proto.Message
+	ClientType() string
+	GetLatestHeight() Height
+}
+
+// ConsensusState is the state of the counterparty chain stored by a light client at a given height
+type ConsensusState interface {
+	proto.Message
+	ClientType() string
+	// GetTimestamp returns the unix timestamp in nanoseconds of the block of the consensus state
+	GetTimestamp() uint64
+}
+
+func NewHeight(revisionNumber, revisionHeight uint64) Height {
+	return Height{
+		RevisionNumber: revisionNumber,
+		RevisionHeight: revisionHeight,
+	}
+}
+
+// IsZero returns true if both the revision number and the revision height are 0
+func (h Height) IsZero() bool {
+	return h.RevisionNumber == 0 && h.RevisionHeight == 0
+}
+
+func (h Height) String() string {
+	return fmt.Sprintf("%d-%d", h.RevisionNumber, h.RevisionHeight)
+}
+
+// RegisterInterfaces registers the client state and consensus state interfaces, the light clients
+// register their implementations
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterInterface("ibc.core.client.v1.ClientState", (*ClientState)(nil))
+	registry.RegisterInterface("ibc.core.client.v1.ConsensusState", (*ConsensusState)(nil))
+}
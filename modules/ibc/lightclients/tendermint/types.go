@@ -0,0 +1,30 @@
+package tendermint
+
+import (
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/client"
+)
+
+const ClientType = "07-tendermint"
+
+var (
+	_ client.ClientState    = &ClientState{}
+	_ client.ConsensusState = &ConsensusState{}
+)
+
+func (cs ClientState) ClientType() string { return ClientType }
+
+func (cs ClientState) GetLatestHeight() client.Height { return cs.LatestHeight }
+
+func (cs ConsensusState) ClientType() string { return ClientType }
+
+func (cs ConsensusState) GetTimestamp() uint64 {
+	return uint64(cs.Timestamp.UnixNano())
+}
+
+// RegisterInterfaces registers the tendermint client state and consensus state as implementations
+// of the interfaces of the client module
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*client.ClientState)(nil), &ClientState{})
+	registry.RegisterImplementations((*client.ConsensusState)(nil), &ConsensusState{})
+}
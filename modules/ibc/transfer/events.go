@@ -0,0 +1,66 @@
+package transfer
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type IBCTransferEvent struct {
+	Sender   string `event:"sender"`
+	Receiver string `event:"receiver"`
+}
+
+func (IBCTransferEvent) EventType() string { return "ibc_transfer" }
+
+// SendPacketEvent is emitted by the channel when a transfer sends its packet to the counterparty chain
+type SendPacketEvent struct {
+	Data             string `event:"packet_data"`
+	TimeoutHeight    string `event:"packet_timeout_height"`
+	TimeoutTimestamp uint64 `event:"packet_timeout_timestamp"`
+	Sequence         uint64 `event:"packet_sequence"`
+	SrcPort          string `event:"packet_src_port"`
+	SrcChannel       string `event:"packet_src_channel"`
+	DstPort          string `event:"packet_dst_port"`
+	DstChannel       string `event:"packet_dst_channel"`
+	ChannelOrdering  string `event:"packet_channel_ordering"`
+	Connection       string `event:"packet_connection"`
+}
+
+func (SendPacketEvent) EventType() string { return "send_packet" }
+
+// FungibleTokenPacketEvent is emitted when a transfer packet is received or acknowledged
+type FungibleTokenPacketEvent struct {
+	Receiver string `event:"receiver"`
+	Denom    string `event:"denom"`
+	Amount   string `event:"amount"`
+	Success  bool   `event:"success"`
+}
+
+func (FungibleTokenPacketEvent) EventType() string { return "fungible_token_packet" }
+
+// DenominationTraceEvent is emitted when a voucher with a new trace is received
+type DenominationTraceEvent struct {
+	TraceHash string `event:"trace_hash"`
+	Denom     string `event:"denom"`
+}
+
+func (DenominationTraceEvent) EventType() string { return "denomination_trace" }
+
+// TimeoutEvent is emitted when the tokens of a timed out transfer are refunded
+type TimeoutEvent struct {
+	RefundReceiver string `event:"refund_receiver"`
+	RefundDenom    string `event:"refund_denom"`
+	RefundAmount   string `event:"refund_amount"`
+}
+
+func (TimeoutEvent) EventType() string { return "timeout" }
+
+// RegisterEvents registers the typed events of the transfer module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		IBCTransferEvent{},
+		SendPacketEvent{},
+		FungibleTokenPacketEvent{},
+		DenominationTraceEvent{},
+		TimeoutEvent{},
+	)
+}
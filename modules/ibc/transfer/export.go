@@ -0,0 +1,103 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/client"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/connection"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose IBC Transfer module api for user
+type Client interface {
+	sdk.Module
+	WithContext(ctx context.Context) Client
+
+	Transfer(request TransferRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QueryDenomTrace(denom string) (DenomTrace, sdk.Error)
+	QueryDenomTraces(page, size uint64) (QueryDenomTracesResp, sdk.Error)
+	QueryParams() (QueryParamsResp, sdk.Error)
+
+	QueryChannel(portID, channelID string) (QueryChannelResp, sdk.Error)
+	QueryChannels(page, size uint64) (QueryChannelsResp, sdk.Error)
+	QueryConnection(connectionID string) (QueryConnectionResp, sdk.Error)
+	QueryConnections(page, size uint64) (QueryConnectionsResp, sdk.Error)
+	QueryClientState(clientID string) (QueryClientStateResp, sdk.Error)
+	QueryClientStates(page, size uint64) (QueryClientStatesResp, sdk.Error)
+	QueryChannelClientState(portID, channelID string) (QueryClientStateResp, sdk.Error)
+}
+
+// TransferRequest is a transfer of tokens to an account of the counterparty chain of a channel.
+// The timeouts are offsets to the latest height and timestamp of the counterparty chain known by
+// the client of the channel, or absolute values with AbsoluteTimeouts; a zero timeout is
+// disabled. The packet times out after DefaultTimeoutHeight or DefaultTimeoutTimestamp
+// when both are zero.
+type TransferRequest struct {
+	// the port of the channel, PortID if empty
+	SourcePort    string      `json:"source_port"`
+	SourceChannel string      `json:"source_channel"`
+	Receiver      string      `json:"receiver"`
+	Amount        sdk.DecCoin `json:"amount"`
+
+	TimeoutHeight client.Height `json:"timeout_height"`
+	// in nanoseconds
+	TimeoutTimestamp uint64 `json:"timeout_timestamp"`
+	AbsoluteTimeouts bool   `json:"absolute_timeouts"`
+}
+
+type QueryDenomTracesResp struct {
+	DenomTraces []DenomTrace `json:"denom_traces"`
+	Total       uint64       `json:"total"`
+}
+
+type QueryParamsResp struct {
+	SendEnabled    bool `json:"send_enabled"`
+	ReceiveEnabled bool `json:"receive_enabled"`
+}
+
+type QueryChannelResp struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+	// STATE_INIT, STATE_TRYOPEN, STATE_OPEN or STATE_CLOSED
+	State string `json:"state"`
+	// ORDER_ORDERED or ORDER_UNORDERED
+	Ordering              string   `json:"ordering"`
+	CounterpartyPortID    string   `json:"counterparty_port_id"`
+	CounterpartyChannelID string   `json:"counterparty_channel_id"`
+	ConnectionHops        []string `json:"connection_hops"`
+	Version               string   `json:"version"`
+}
+
+type QueryChannelsResp struct {
+	Channels []QueryChannelResp `json:"channels"`
+	Total    uint64             `json:"total"`
+}
+
+type QueryConnectionResp struct {
+	ID       string `json:"id"`
+	ClientID string `json:"client_id"`
+	// STATE_INIT, STATE_TRYOPEN or STATE_OPEN
+	State                    string               `json:"state"`
+	CounterpartyClientID     string               `json:"counterparty_client_id"`
+	CounterpartyConnectionID string               `json:"counterparty_connection_id"`
+	Versions                 []connection.Version `json:"versions"`
+	DelayPeriod              uint64               `json:"delay_period"`
+}
+
+type QueryConnectionsResp struct {
+	Connections []QueryConnectionResp `json:"connections"`
+	Total       uint64                `json:"total"`
+}
+
+type QueryClientStateResp struct {
+	ClientID     string             `json:"client_id"`
+	ClientType   string             `json:"client_type"`
+	LatestHeight client.Height      `json:"latest_height"`
+	ClientState  client.ClientState `json:"client_state"`
+}
+
+type QueryClientStatesResp struct {
+	ClientStates []QueryClientStateResp `json:"client_states"`
+	Total        uint64                 `json:"total"`
+}
@@ -0,0 +1,398 @@
+package transfer
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/channel"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/client"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/connection"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type transferClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler) Client {
+	return &transferClient{
+		BaseClient: baseClient,
+		Marshaler:  marshaler,
+	}
+}
+
+func (tc transferClient) Name() string {
+	return ModuleName
+}
+
+func (tc transferClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (tc transferClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (tc transferClient) WithContext(ctx context.Context) Client {
+	return NewClient(tc.BaseClient.WithContext(ctx), tc.Marshaler)
+}
+
+// Transfer sends the tokens through the channel to the receiver on the counterparty chain,
+// the sequence of the packet is in the SendPacketEvent of the result
+func (tc transferClient) Transfer(request TransferRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	sender, err := tc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	amount, err := tc.ToMinCoin(request.Amount)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	portID := request.SourcePort
+	if len(portID) == 0 {
+		portID = PortID
+	}
+
+	timeoutHeight, timeoutTimestamp := request.TimeoutHeight, request.TimeoutTimestamp
+	if !request.AbsoluteTimeouts {
+		if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+			timeoutHeight, timeoutTimestamp = DefaultTimeoutHeight, DefaultTimeoutTimestamp
+		}
+		var e sdk.Error
+		timeoutHeight, timeoutTimestamp, e = tc.absoluteTimeouts(portID, request.SourceChannel, timeoutHeight, timeoutTimestamp)
+		if e != nil {
+			return sdk.ResultTx{}, e
+		}
+	}
+
+	msg := &MsgTransfer{
+		SourcePort:       portID,
+		SourceChannel:    request.SourceChannel,
+		Token:            amount[0],
+		Sender:           sender.String(),
+		Receiver:         request.Receiver,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+	return tc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// absoluteTimeouts adds the latest height of the counterparty chain known by the client of the
+// channel, and the timestamp of its consensus state at that height, to the non-zero timeouts
+func (tc transferClient) absoluteTimeouts(portID, channelID string, heightOffset client.Height, timestampOffset uint64) (client.Height, uint64, sdk.Error) {
+	clientState, err := tc.QueryChannelClientState(portID, channelID)
+	if err != nil {
+		return client.Height{}, 0, err
+	}
+	if clientState.ClientState == nil {
+		return client.Height{}, 0, sdk.Wrapf("unsupported client %s of the channel %s", clientState.ClientID, channelID)
+	}
+
+	latest := clientState.LatestHeight
+	var timeoutHeight client.Height
+	if !heightOffset.IsZero() {
+		timeoutHeight = client.NewHeight(
+			latest.RevisionNumber+heightOffset.RevisionNumber,
+			latest.RevisionHeight+heightOffset.RevisionHeight,
+		)
+	}
+	if timestampOffset == 0 {
+		return timeoutHeight, 0, nil
+	}
+
+	conn, e := tc.GenConn()
+	if e != nil {
+		return client.Height{}, 0, sdk.Wrap(e)
+	}
+
+	res, e := channel.NewQueryClient(conn).ChannelConsensusState(
+		tc.Context(),
+		&channel.QueryChannelConsensusStateRequest{
+			PortId:         portID,
+			ChannelId:      channelID,
+			RevisionNumber: latest.RevisionNumber,
+			RevisionHeight: latest.RevisionHeight,
+		},
+	)
+	if e != nil {
+		return client.Height{}, 0, sdk.Wrap(e)
+	}
+
+	var consensusState client.ConsensusState
+	if e = tc.UnpackAny(res.ConsensusState, &consensusState); e != nil {
+		return client.Height{}, 0, sdk.Wrap(e)
+	}
+	return timeoutHeight, consensusState.GetTimestamp() + timestampOffset, nil
+}
+
+// QueryDenomTrace returns the trace of an ibc/{hash} denom, or of the hash alone
+func (tc transferClient) QueryDenomTrace(denom string) (DenomTrace, sdk.Error) {
+	hash := denom
+	if IsIBCDenom(denom) {
+		var err error
+		if hash, err = ParseIBCDenom(denom); err != nil {
+			return DenomTrace{}, sdk.Wrap(err)
+		}
+	}
+
+	conn, err := tc.GenConn()
+	if err != nil {
+		return DenomTrace{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).DenomTrace(
+		tc.Context(),
+		&QueryDenomTraceRequest{Hash: hash},
+	)
+	if err != nil {
+		return DenomTrace{}, sdk.Wrap(err)
+	}
+	return *res.DenomTrace, nil
+}
+
+func (tc transferClient) QueryDenomTraces(page, size uint64) (QueryDenomTracesResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryDenomTracesResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).DenomTraces(
+		tc.Context(),
+		&QueryDenomTracesRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryDenomTracesResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryDenomTracesResp), nil
+}
+
+func (tc transferClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Params(
+		tc.Context(),
+		&QueryParamsRequest{},
+	)
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
+
+func (tc transferClient) QueryChannel(portID, channelID string) (QueryChannelResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryChannelResp{}, sdk.Wrap(err)
+	}
+
+	res, err := channel.NewQueryClient(conn).Channel(
+		tc.Context(),
+		&channel.QueryChannelRequest{
+			PortId:    portID,
+			ChannelId: channelID,
+		},
+	)
+	if err != nil {
+		return QueryChannelResp{}, sdk.Wrap(err)
+	}
+	return convertChannel(channel.IdentifiedChannel{
+		State:          res.Channel.State,
+		Ordering:       res.Channel.Ordering,
+		Counterparty:   res.Channel.Counterparty,
+		ConnectionHops: res.Channel.ConnectionHops,
+		Version:        res.Channel.Version,
+		PortId:         portID,
+		ChannelId:      channelID,
+	}), nil
+}
+
+func (tc transferClient) QueryChannels(page, size uint64) (QueryChannelsResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryChannelsResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := channel.NewQueryClient(conn).Channels(
+		tc.Context(),
+		&channel.QueryChannelsRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryChannelsResp{}, sdk.Wrap(err)
+	}
+
+	channels := make([]QueryChannelResp, len(res.Channels))
+	for i, c := range res.Channels {
+		channels[i] = convertChannel(*c)
+	}
+	return QueryChannelsResp{
+		Channels: channels,
+		Total:    res.Pagination.GetTotal(),
+	}, nil
+}
+
+func (tc transferClient) QueryConnection(connectionID string) (QueryConnectionResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryConnectionResp{}, sdk.Wrap(err)
+	}
+
+	res, err := connection.NewQueryClient(conn).Connection(
+		tc.Context(),
+		&connection.QueryConnectionRequest{
+			ConnectionId: connectionID,
+		},
+	)
+	if err != nil {
+		return QueryConnectionResp{}, sdk.Wrap(err)
+	}
+	return convertConnection(connection.IdentifiedConnection{
+		Id:           connectionID,
+		ClientId:     res.Connection.ClientId,
+		Versions:     res.Connection.Versions,
+		State:        res.Connection.State,
+		Counterparty: res.Connection.Counterparty,
+		DelayPeriod:  res.Connection.DelayPeriod,
+	}), nil
+}
+
+func (tc transferClient) QueryConnections(page, size uint64) (QueryConnectionsResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryConnectionsResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := connection.NewQueryClient(conn).Connections(
+		tc.Context(),
+		&connection.QueryConnectionsRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryConnectionsResp{}, sdk.Wrap(err)
+	}
+
+	connections := make([]QueryConnectionResp, len(res.Connections))
+	for i, c := range res.Connections {
+		connections[i] = convertConnection(*c)
+	}
+	return QueryConnectionsResp{
+		Connections: connections,
+		Total:       res.Pagination.GetTotal(),
+	}, nil
+}
+
+func (tc transferClient) QueryClientState(clientID string) (QueryClientStateResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryClientStateResp{}, sdk.Wrap(err)
+	}
+
+	res, err := client.NewQueryClient(conn).ClientState(
+		tc.Context(),
+		&client.QueryClientStateRequest{
+			ClientId: clientID,
+		},
+	)
+	if err != nil {
+		return QueryClientStateResp{}, sdk.Wrap(err)
+	}
+	return tc.convertClientState(client.IdentifiedClientState{
+		ClientId:    clientID,
+		ClientState: res.ClientState,
+	}), nil
+}
+
+func (tc transferClient) QueryClientStates(page, size uint64) (QueryClientStatesResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryClientStatesResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := client.NewQueryClient(conn).ClientStates(
+		tc.Context(),
+		&client.QueryClientStatesRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QueryClientStatesResp{}, sdk.Wrap(err)
+	}
+
+	clientStates := make([]QueryClientStateResp, len(res.ClientStates))
+	for i, cs := range res.ClientStates {
+		clientStates[i] = tc.convertClientState(cs)
+	}
+	return QueryClientStatesResp{
+		ClientStates: clientStates,
+		Total:        res.Pagination.GetTotal(),
+	}, nil
+}
+
+// QueryChannelClientState returns the state of the client of the connection of the channel
+func (tc transferClient) QueryChannelClientState(portID, channelID string) (QueryClientStateResp, sdk.Error) {
+	conn, err := tc.GenConn()
+	if err != nil {
+		return QueryClientStateResp{}, sdk.Wrap(err)
+	}
+
+	res, err := channel.NewQueryClient(conn).ChannelClientState(
+		tc.Context(),
+		&channel.QueryChannelClientStateRequest{
+			PortId:    portID,
+			ChannelId: channelID,
+		},
+	)
+	if err != nil {
+		return QueryClientStateResp{}, sdk.Wrap(err)
+	}
+	return tc.convertClientState(*res.IdentifiedClientState), nil
+}
+
+// convertClientState unpacks the client state, which is left nil for the light clients
+// other than tendermint
+func (tc transferClient) convertClientState(cs client.IdentifiedClientState) QueryClientStateResp {
+	resp := QueryClientStateResp{ClientID: cs.ClientId}
+	var clientState client.ClientState
+	if err := tc.UnpackAny(cs.ClientState, &clientState); err != nil {
+		tc.Logger().Debug("unpack client state failed", "client_id", cs.ClientId, "err", err.Error())
+		return resp
+	}
+	resp.ClientType = clientState.ClientType()
+	resp.LatestHeight = clientState.GetLatestHeight()
+	resp.ClientState = clientState
+	return resp
+}
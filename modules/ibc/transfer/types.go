@@ -0,0 +1,182 @@
+package transfer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/channel"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/client"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/core/connection"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "transfer"
+
+	// PortID is the port bound by the transfer module
+	PortID = "transfer"
+
+	// DenomPrefix prefixes the hash of the trace of the vouchers received through IBC
+	DenomPrefix = "ibc"
+)
+
+var (
+	_ sdk.Msg = &MsgTransfer{}
+
+	// DefaultTimeoutHeight is the default offset of the timeout height of a transfer
+	DefaultTimeoutHeight = client.NewHeight(0, 1000)
+	// DefaultTimeoutTimestamp is the default offset of the timeout timestamp of a transfer
+	DefaultTimeoutTimestamp = uint64((10 * time.Minute).Nanoseconds())
+)
+
+func (msg MsgTransfer) Route() string { return ModuleName }
+
+func (msg MsgTransfer) Type() string { return "transfer" }
+
+func (msg MsgTransfer) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg MsgTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgTransfer) ValidateBasic() error {
+	if len(msg.SourcePort) == 0 {
+		return errors.New("source port can not be empty")
+	}
+	if len(msg.SourceChannel) == 0 {
+		return errors.New("source channel can not be empty")
+	}
+	if !msg.Token.IsValid() || !msg.Token.IsPositive() {
+		return fmt.Errorf("invalid token: %s", msg.Token)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdk.WrapWithMessage(err, "invalid sender address")
+	}
+	if len(strings.TrimSpace(msg.Receiver)) == 0 {
+		return errors.New("receiver can not be empty")
+	}
+	if msg.TimeoutHeight.IsZero() && msg.TimeoutTimestamp == 0 {
+		return errors.New("timeout height and timeout timestamp can not both be 0")
+	}
+	return nil
+}
+
+// ParseDenomTrace parses the full path of a denom, e.g. transfer/channel-0/uatom, into its trace
+func ParseDenomTrace(rawDenom string) DenomTrace {
+	denomSplit := strings.Split(rawDenom, "/")
+	if len(denomSplit) == 1 {
+		return DenomTrace{BaseDenom: rawDenom}
+	}
+	return DenomTrace{
+		Path:      strings.Join(denomSplit[:len(denomSplit)-1], "/"),
+		BaseDenom: denomSplit[len(denomSplit)-1],
+	}
+}
+
+// ParseIBCDenom returns the hash of the trace of an ibc/{hash} denom
+func ParseIBCDenom(denom string) (string, error) {
+	denomSplit := strings.SplitN(denom, "/", 2)
+	if len(denomSplit) != 2 || !strings.EqualFold(denomSplit[0], DenomPrefix) {
+		return "", fmt.Errorf("invalid ibc denom %s, expected %s/{hash}", denom, DenomPrefix)
+	}
+	if _, err := hex.DecodeString(denomSplit[1]); err != nil || len(denomSplit[1]) != 2*sha256.Size {
+		return "", fmt.Errorf("invalid hash of the ibc denom %s", denom)
+	}
+	return strings.ToUpper(denomSplit[1]), nil
+}
+
+// IsIBCDenom returns true if the denom has the ibc/ prefix of the vouchers received through IBC
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(strings.ToLower(denom), DenomPrefix+"/")
+}
+
+// IsDenomPath returns true if the denom is a base denom prefixed by the port and channel pairs
+// of its trace, e.g. transfer/channel-0/uatom
+func IsDenomPath(denom string) bool {
+	denomSplit := strings.Split(denom, "/")
+	if len(denomSplit)%2 == 0 {
+		return false
+	}
+	for _, s := range denomSplit {
+		if len(strings.TrimSpace(s)) == 0 {
+			return false
+		}
+	}
+	return len(denomSplit) > 1
+}
+
+// GetFullDenomPath returns the path of the base denom, e.g. transfer/channel-0/uatom
+func (dt DenomTrace) GetFullDenomPath() string {
+	if dt.Path == "" {
+		return dt.BaseDenom
+	}
+	return dt.Path + "/" + dt.BaseDenom
+}
+
+// Hash returns the upper case hex of the sha256 hash of the full denom path
+func (dt DenomTrace) Hash() string {
+	hash := sha256.Sum256([]byte(dt.GetFullDenomPath()))
+	return strings.ToUpper(hex.EncodeToString(hash[:]))
+}
+
+// IBCDenom returns the ibc/{hash} denom of the trace, or the base denom of a native token
+func (dt DenomTrace) IBCDenom() string {
+	if dt.Path == "" {
+		return dt.BaseDenom
+	}
+	return fmt.Sprintf("%s/%s", DenomPrefix, dt.Hash())
+}
+
+func (q QueryDenomTracesResponse) Convert() interface{} {
+	return QueryDenomTracesResp{
+		DenomTraces: q.DenomTraces,
+		Total:       q.Pagination.GetTotal(),
+	}
+}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return QueryParamsResp{
+		SendEnabled:    q.Params.GetSendEnabled(),
+		ReceiveEnabled: q.Params.GetReceiveEnabled(),
+	}
+}
+
+func convertChannel(c channel.IdentifiedChannel) QueryChannelResp {
+	return QueryChannelResp{
+		PortID:                c.PortId,
+		ChannelID:             c.ChannelId,
+		State:                 c.State.String(),
+		Ordering:              c.Ordering.String(),
+		CounterpartyPortID:    c.Counterparty.PortId,
+		CounterpartyChannelID: c.Counterparty.ChannelId,
+		ConnectionHops:        c.ConnectionHops,
+		Version:               c.Version,
+	}
+}
+
+func convertConnection(c connection.IdentifiedConnection) QueryConnectionResp {
+	versions := make([]connection.Version, len(c.Versions))
+	for i, version := range c.Versions {
+		versions[i] = *version
+	}
+	return QueryConnectionResp{
+		ID:                       c.Id,
+		ClientID:                 c.ClientId,
+		State:                    c.State.String(),
+		CounterpartyClientID:     c.Counterparty.ClientId,
+		CounterpartyConnectionID: c.Counterparty.ConnectionId,
+		Versions:                 versions,
+		DelayPeriod:              c.DelayPeriod,
+	}
+}
@@ -109,7 +109,7 @@ func (k keyManager) Import(name, password, armor string) (string, error) {
 
 	km := crypto.NewKeyManager()
 
-	priv, _, err := km.ImportPrivKey(armor, password)
+	priv, algo, err := km.ImportPrivKey(armor, password)
 	if err != nil {
 		return "", err
 	}
@@ -121,7 +121,7 @@ func (k keyManager) Import(name, password, armor string) (string, error) {
 		Name:         name,
 		PubKey:       cryptoamino.MarshalPubkey(pubKey),
 		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
-		Algo:         k.algo,
+		Algo:         algo,
 	}
 
 	err = k.keyDAO.Write(name, password, info)
@@ -162,3 +162,15 @@ func (k keyManager) Find(name, password string) (tmcrypto.PubKey, types.AccAddre
 
 	return pubKey, types.AccAddress(pubKey.Address().Bytes()), nil
 }
+
+func (k keyManager) List() ([]store.KeyMetadata, error) {
+	return k.keyDAO.List()
+}
+
+func (k keyManager) Rename(name, newName, password string) error {
+	return k.keyDAO.Rename(name, newName, password)
+}
+
+func (k keyManager) ChangePassword(name, oldPassword, newPassword string) error {
+	return k.keyDAO.ChangePassword(name, oldPassword, newPassword)
+}
@@ -1,6 +1,8 @@
 package keys
 
 import (
+	"time"
+
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
@@ -12,4 +14,17 @@ type Client interface {
 	Export(name, password string) (privKeyArmor string, err sdk.Error)
 	Delete(name, password string) sdk.Error
 	Show(name, password string) (string, sdk.Error)
+	List() ([]KeyOutput, sdk.Error)
+	Rename(name, newName, password string) sdk.Error
+	ChangePassword(name, oldPassword, newPassword string) sdk.Error
+}
+
+// KeyOutput is the public information of a key, the Address and PubKey are empty
+// if the KeyDAO can't read the public key without the password
+type KeyOutput struct {
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	PubKey    string    `json:"pubkey"`
+	Algo      string    `json:"algo"`
+	CreatedAt time.Time `json:"created_at"`
 }
@@ -1,6 +1,7 @@
 package keys
 
 import (
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
@@ -49,3 +50,43 @@ func (k keysClient) Show(name, password string) (string, sdk.Error) {
 	}
 	return address.String(), nil
 }
+
+func (k keysClient) List() ([]KeyOutput, sdk.Error) {
+	keys, err := k.KeyManager.List()
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	outputs := make([]KeyOutput, 0, len(keys))
+	for _, key := range keys {
+		output := KeyOutput{
+			Name:      key.Name,
+			Algo:      key.Algo,
+			CreatedAt: key.CreatedAt,
+		}
+
+		if len(key.PubKey) > 0 {
+			pubKey, err := cryptoamino.PubKeyFromBytes(key.PubKey)
+			if err != nil {
+				return nil, sdk.Wrap(err)
+			}
+
+			output.Address = sdk.AccAddress(pubKey.Address().Bytes()).String()
+			if output.PubKey, err = sdk.Bech32ifyPubKey(sdk.Bech32PubKeyTypeAccPub, pubKey); err != nil {
+				return nil, sdk.Wrap(err)
+			}
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs, nil
+}
+
+func (k keysClient) Rename(name, newName, password string) sdk.Error {
+	err := k.KeyManager.Rename(name, newName, password)
+	return sdk.Wrap(err)
+}
+
+func (k keysClient) ChangePassword(name, oldPassword, newPassword string) sdk.Error {
+	err := k.KeyManager.ChangePassword(name, oldPassword, newPassword)
+	return sdk.Wrap(err)
+}
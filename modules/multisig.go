@@ -0,0 +1,150 @@
+package modules
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	clienttx "github.com/irisnet/irishub-sdk-go/client/tx"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/tx"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
+)
+
+// BuildUnsignedTx builds a transaction without any signature and returns its json encoding,
+// it's the first step of the multisig workflow and of the workflow of a fee payer signing offline
+func (base *baseClient) BuildUnsignedTx(msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, sdk.Error) {
+	builder, err := base.prepareTemp("", 0, 0, baseTx)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	if err := base.prepareFeePayment(builder, baseTx, true, nil); err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	txBytes, err := builder.GenerateOnly(msgs)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return txBytes, nil
+}
+
+// SignMultisigTx signs the unsigned transaction on behalf of a multisig account with the key `baseTx.From`
+// and returns the json encoding of the partial signature. The accountNumber and sequence are the ones of
+// the multisig account, so that the signer doesn't need to access the network. A transaction with several
+// signers, such as a fee payer, is signed the same way by each of them with the ones of its own account.
+func (base *baseClient) SignMultisigTx(unsignedTx []byte, accountNumber, sequence uint64, baseTx sdk.BaseTx) ([]byte, sdk.Error) {
+	builder := base.offlineFactory(accountNumber, sequence).WithPassword(baseTx.Password)
+
+	txBuilder, err := base.decodeTxJSON(unsignedTx)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	sig, err := builder.SignMultisig(baseTx.From, txBuilder)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	bz, err := base.encodingConfig.TxConfig.MarshalSignatureJSON([]signing.SignatureV2{sig})
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	base.Logger().Debug("sign multisig transaction success", "signer", baseTx.From)
+	return bz, nil
+}
+
+// MultiSignTx combines the partial signatures produced by SignMultisigTx into a multi-signature
+// of multisigPubKey, and returns the json encoding of the signed transaction
+func (base *baseClient) MultiSignTx(multisigPubKey crypto.PubKey, unsignedTx []byte, accountNumber, sequence uint64, signatures ...[]byte) ([]byte, sdk.Error) {
+	builder := base.offlineFactory(accountNumber, sequence)
+
+	txBuilder, err := base.decodeTxJSON(unsignedTx)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	var sigs []signing.SignatureV2
+	for _, bz := range signatures {
+		sig, err := base.encodingConfig.TxConfig.UnmarshalSignatureJSON(bz)
+		if err != nil {
+			return nil, sdk.Wrap(err)
+		}
+		sigs = append(sigs, sig...)
+	}
+
+	if err := builder.MultiSign(multisigPubKey, txBuilder, sigs...); err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	txBytes, err := base.encodingConfig.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return txBytes, nil
+}
+
+// CombineSignaturesTx populates the unsigned transaction with the signatures produced by SignMultisigTx
+// for each of its signers, such as the sender and the fee payer, and returns the json encoding of the
+// signed transaction
+func (base *baseClient) CombineSignaturesTx(unsignedTx []byte, signatures ...[]byte) ([]byte, sdk.Error) {
+	txBuilder, err := base.decodeTxJSON(unsignedTx)
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	var sigs []signing.SignatureV2
+	for _, bz := range signatures {
+		sig, err := base.encodingConfig.TxConfig.UnmarshalSignatureJSON(bz)
+		if err != nil {
+			return nil, sdk.Wrap(err)
+		}
+		sigs = append(sigs, sig...)
+	}
+
+	if err := base.offlineFactory(0, 0).CombineSignatures(txBuilder, sigs...); err != nil {
+		return nil, sdk.Wrap(err)
+	}
+
+	txBytes, err := base.encodingConfig.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return nil, sdk.Wrap(err)
+	}
+	return txBytes, nil
+}
+
+// BroadcastSignedTx broadcasts a json encoded signed transaction, such as the one returned by MultiSignTx
+func (base *baseClient) BroadcastSignedTx(signedTx []byte, mode sdk.BroadcastMode) (sdk.ResultTx, sdk.Error) {
+	txBuilder, err := base.decodeTxJSON(signedTx)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	txBytes, err := base.encodingConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	if len(mode) == 0 {
+		mode = base.cfg.Mode
+	}
+	return base.broadcastTx(txBytes, mode, false)
+}
+
+func (base *baseClient) offlineFactory(accountNumber, sequence uint64) *clienttx.Factory {
+	return clienttx.NewFactory().
+		WithChainID(base.cfg.ChainID).
+		WithSigner(base.signer).
+		WithAccountNumber(accountNumber).
+		WithSequence(sequence).
+		WithSignModeHandler(tx.MakeSignModeHandler(tx.DefaultSignModes)).
+		WithTxConfig(base.encodingConfig.TxConfig)
+}
+
+func (base *baseClient) decodeTxJSON(txJSON []byte) (sdk.TxBuilder, error) {
+	decoded, err := base.encodingConfig.TxConfig.TxJSONDecoder()(txJSON)
+	if err != nil {
+		return nil, err
+	}
+	return base.encodingConfig.TxConfig.WrapTxBuilder(decoded)
+}
@@ -0,0 +1,59 @@
+package nft
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type IssueDenomEvent struct {
+	DenomID   string `event:"denom_id"`
+	DenomName string `event:"denom_name"`
+	Creator   string `event:"creator"`
+}
+
+func (IssueDenomEvent) EventType() string { return "issue_denom" }
+
+type MintNFTEvent struct {
+	TokenID   string `event:"token_id"`
+	DenomID   string `event:"denom_id"`
+	TokenURI  string `event:"token_uri"`
+	Recipient string `event:"recipient"`
+}
+
+func (MintNFTEvent) EventType() string { return "mint_nft" }
+
+type EditNFTEvent struct {
+	TokenID  string `event:"token_id"`
+	DenomID  string `event:"denom_id"`
+	TokenURI string `event:"token_uri"`
+	Owner    string `event:"owner"`
+}
+
+func (EditNFTEvent) EventType() string { return "edit_nft" }
+
+type TransferNFTEvent struct {
+	TokenID   string `event:"token_id"`
+	DenomID   string `event:"denom_id"`
+	Sender    string `event:"sender"`
+	Recipient string `event:"recipient"`
+}
+
+func (TransferNFTEvent) EventType() string { return "transfer_nft" }
+
+type BurnNFTEvent struct {
+	DenomID string `event:"denom_id"`
+	TokenID string `event:"token_id"`
+	Owner   string `event:"owner"`
+}
+
+func (BurnNFTEvent) EventType() string { return "burn_nft" }
+
+// RegisterEvents registers the typed events of the nft module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		IssueDenomEvent{},
+		MintNFTEvent{},
+		EditNFTEvent{},
+		TransferNFTEvent{},
+		BurnNFTEvent{},
+	)
+}
@@ -1,10 +1,15 @@
 package nft
 
-import sdk "github.com/irisnet/irishub-sdk-go/types"
+import (
+	"context"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
 
 // expose NFT module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	IssueDenom(request IssueDenomRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	MintNFT(request MintNFTRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
@@ -18,6 +23,8 @@ type Client interface {
 	QueryDenom(denomID string) (QueryDenomResp, sdk.Error)
 	QueryDenoms() ([]QueryDenomResp, sdk.Error)
 	QueryNFT(denomID, tokenID string) (QueryNFTResp, sdk.Error)
+	SubscribeMintNFTTx(denomID, recipient string, callback EventMsgMintNFTCallback) (sdk.Subscription, sdk.Error)
+	SubscribeTransferNFTTx(denomID, tokenID string, callback EventMsgTransferNFTCallback) (sdk.Subscription, sdk.Error)
 }
 
 type IssueDenomRequest struct {
@@ -88,3 +95,7 @@ type QueryCollectionResp struct {
 	Denom QueryDenomResp `json:"denom" yaml:"denom"`
 	NFTs  []QueryNFTResp `json:"nfts" yaml:"nfts"`
 }
+
+type EventMsgMintNFTCallback func(msg *MsgMintNFT, data sdk.EventDataMsg)
+
+type EventMsgTransferNFTCallback func(msg *MsgTransferNFT, data sdk.EventDataMsg)
@@ -29,6 +29,15 @@ func (nc nftClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 	RegisterInterfaces(registry)
 }
 
+func (nc nftClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (nc nftClient) WithContext(ctx context.Context) Client {
+	return NewClient(nc.BaseClient.WithContext(ctx), nc.Marshaler)
+}
+
 func (nc nftClient) IssueDenom(request IssueDenomRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	sender, err := nc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
@@ -133,13 +142,12 @@ func (nc nftClient) QuerySupply(denom, creator string) (uint64, sdk.Error) {
 	}
 
 	conn, err := nc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return 0, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Supply(
-		context.Background(),
+		nc.Context(),
 		&QuerySupplyRequest{
 			Owner:   creator,
 			DenomId: denom,
@@ -162,13 +170,12 @@ func (nc nftClient) QueryOwner(creator, denom string) (QueryOwnerResp, sdk.Error
 	}
 
 	conn, err := nc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryOwnerResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Owner(
-		context.Background(),
+		nc.Context(),
 		&QueryOwnerRequest{
 			Owner:   creator,
 			DenomId: denom,
@@ -187,13 +194,12 @@ func (nc nftClient) QueryCollection(denom string) (QueryCollectionResp, sdk.Erro
 	}
 
 	conn, err := nc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryCollectionResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Collection(
-		context.Background(),
+		nc.Context(),
 		&QueryCollectionRequest{DenomId: denom},
 	)
 	if err != nil {
@@ -205,13 +211,12 @@ func (nc nftClient) QueryCollection(denom string) (QueryCollectionResp, sdk.Erro
 
 func (nc nftClient) QueryDenoms() ([]QueryDenomResp, sdk.Error) {
 	conn, err := nc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Denoms(
-		context.Background(),
+		nc.Context(),
 		&QueryDenomsRequest{},
 	)
 	if err != nil {
@@ -223,13 +228,12 @@ func (nc nftClient) QueryDenoms() ([]QueryDenomResp, sdk.Error) {
 
 func (nc nftClient) QueryDenom(denom string) (QueryDenomResp, sdk.Error) {
 	conn, err := nc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryDenomResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Denom(
-		context.Background(),
+		nc.Context(),
 		&QueryDenomRequest{DenomId: denom},
 	)
 	if err != nil {
@@ -249,13 +253,12 @@ func (nc nftClient) QueryNFT(denom, tokenID string) (QueryNFTResp, sdk.Error) {
 	}
 
 	conn, err := nc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryNFTResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).NFT(
-		context.Background(),
+		nc.Context(),
 		&QueryNFTRequest{
 			DenomId: denom,
 			TokenId: tokenID,
@@ -267,3 +270,27 @@ func (nc nftClient) QueryNFT(denom, tokenID string) (QueryNFTResp, sdk.Error) {
 
 	return res.NFT.Convert().(QueryNFTResp), nil
 }
+
+// SubscribeMintNFTTx subscribes the NFTs of the denom minted to the recipient, an empty denom or recipient matches any
+func (nc nftClient) SubscribeMintNFTTx(denomID, recipient string, callback EventMsgMintNFTCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgMintNFT{}.Type())
+	return sdk.SubscribeMsgs(nc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgMintNFT)
+		if !ok || (len(denomID) > 0 && msg.DenomId != denomID) || (len(recipient) > 0 && msg.Recipient != recipient) {
+			return
+		}
+		callback(msg, data)
+	})
+}
+
+// SubscribeTransferNFTTx subscribes the transfers of the NFTs of the denom, an empty token id matches any NFT of the denom
+func (nc nftClient) SubscribeTransferNFTTx(denomID, tokenID string, callback EventMsgTransferNFTCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgTransferNFT{}.Type())
+	return sdk.SubscribeMsgs(nc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgTransferNFT)
+		if !ok || (len(denomID) > 0 && msg.DenomId != denomID) || (len(tokenID) > 0 && msg.Id != tokenID) {
+			return
+		}
+		callback(msg, data)
+	})
+}
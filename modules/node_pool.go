@@ -0,0 +1,446 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+var (
+	errNoNode          = errors.New("no rpc node available")
+	errSubscribeOnPool = errors.New("subscribe with the Subscribe* methods of the TmClient")
+)
+
+// node is a full node of the pool with the result of its last health check
+type node struct {
+	index  int
+	remote string
+	client rpc.Client
+
+	// guarded by nodePool.mu
+	checked    bool
+	reachable  bool
+	catchingUp bool
+	height     int64
+	latency    time.Duration
+}
+
+// nodePool is a rpc.Client spreading the requests over several full nodes. The nodes are
+// health-checked periodically, a node is avoided while it is unreachable, catching up or
+// lagging more than maxHeightLag blocks behind the highest known height.
+//
+// The queries are balanced among the healthy nodes. The broadcasts and the websocket of the
+// subscriptions stick to a primary node, so that the txs of an account reach the same mempool in the
+// order of their sequences, and the primary is replaced by the healthy node with the
+// lowest latency when it becomes unhealthy. A request fails over to the next node on a
+// transport error, the errors returned by the node itself are not retried.
+type nodePool struct {
+	*service.BaseService
+
+	nodes        []*node
+	interval     time.Duration
+	timeout      time.Duration
+	maxHeightLag int64
+	// called with the indexes of the healthy nodes after every health check
+	onHealth func(healthy []int)
+
+	mu      sync.RWMutex
+	primary *node
+	next    uint32
+}
+
+// dialNodes creates the clients of the remotes, the invalid remotes are logged and skipped
+func dialNodes(remotes []string, timeout uint, logger log.Logger) []*node {
+	var nodes []*node
+	for i, remote := range remotes {
+		client, err := rpchttp.NewWithTimeout(remote, "/websocket", timeout)
+		if err != nil {
+			logger.Error("invalid rpc node", "node", remote, "errMsg", err.Error())
+			continue
+		}
+		nodes = append(nodes, &node{index: i, remote: remote, client: client})
+	}
+	return nodes
+}
+
+func newNodePool(nodes []*node, interval, timeout time.Duration, maxHeightLag int64, logger log.Logger) *nodePool {
+	p := &nodePool{
+		nodes:        nodes,
+		interval:     interval,
+		timeout:      timeout,
+		maxHeightLag: maxHeightLag,
+	}
+	p.BaseService = service.NewBaseService(logger, "nodePool", p)
+	return p
+}
+
+// OnStart starts the health checks, a single node is not checked since the requests have no
+// other node to fail over to. The websockets of the node clients are never started, the
+// events are carried by the connection of subscriptions.
+func (p *nodePool) OnStart() error {
+	if len(p.nodes) > 1 {
+		go p.healthLoop()
+	}
+	return nil
+}
+
+func (p *nodePool) healthLoop() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.checkHealth()
+		select {
+		case <-p.Quit():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkHealth queries the status of every node and records its height, whether it is
+// catching up and its latency
+func (p *nodePool) checkHealth() {
+	var wg sync.WaitGroup
+	for _, n := range p.nodes {
+		wg.Add(1)
+		go func(n *node) {
+			defer wg.Done()
+
+			ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+			defer cancel()
+			start := time.Now()
+			status, err := n.client.Status(ctx)
+			latency := time.Since(start)
+
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			n.checked = true
+			n.reachable = err == nil
+			if err != nil {
+				p.Logger.Info("rpc node unreachable", "node", n.remote, "errMsg", err.Error())
+				return
+			}
+			n.catchingUp = status.SyncInfo.CatchingUp
+			n.height = status.SyncInfo.LatestBlockHeight
+			n.latency = latency
+		}(n)
+	}
+	wg.Wait()
+
+	if p.onHealth != nil {
+		var healthy []int
+		for _, n := range p.healthy() {
+			healthy = append(healthy, n.index)
+		}
+		p.onHealth(healthy)
+	}
+}
+
+// healthy returns the healthy nodes in the configured order, a node not checked yet is healthy
+func (p *nodePool) healthy() []*node {
+	healthy, _ := p.partition()
+	return healthy
+}
+
+func (p *nodePool) partition() (healthy, unhealthy []*node) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var best int64
+	for _, n := range p.nodes {
+		if n.reachable && n.height > best {
+			best = n.height
+		}
+	}
+
+	for _, n := range p.nodes {
+		if !n.checked || (n.reachable && !n.catchingUp && best-n.height <= p.maxHeightLag) {
+			healthy = append(healthy, n)
+		} else {
+			unhealthy = append(unhealthy, n)
+		}
+	}
+	return healthy, unhealthy
+}
+
+// balanced returns the nodes in the order a query tries them, the healthy nodes take turns
+// first and the unhealthy ones are the last resort
+func (p *nodePool) balanced() []*node {
+	healthy, unhealthy := p.partition()
+	if len(healthy) > 0 {
+		i := int(atomic.AddUint32(&p.next, 1)-1) % len(healthy)
+		healthy = append(healthy[i:], healthy[:i]...)
+	}
+	return append(healthy, unhealthy...)
+}
+
+// preferred returns the nodes in the order a broadcast or a subscription tries them,
+// starting with the primary node
+func (p *nodePool) preferred() []*node {
+	healthy, unhealthy := p.partition()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sort.SliceStable(healthy, func(i, j int) bool {
+		return healthy[i].latency < healthy[j].latency
+	})
+
+	primary := -1
+	for i, n := range healthy {
+		if n == p.primary {
+			primary = i
+		}
+	}
+	switch {
+	case primary > 0:
+		healthy = append(append([]*node{p.primary}, healthy[:primary]...), healthy[primary+1:]...)
+	case primary < 0 && len(healthy) > 0:
+		if p.primary != nil {
+			p.Logger.Info("switch primary rpc node", "from", p.primary.remote, "to", healthy[0].remote)
+		}
+		p.primary = healthy[0]
+	}
+	return append(healthy, unhealthy...)
+}
+
+// try calls fn with the nodes in order until one of them is reachable, the next node
+// is tried on the errors for which failover returns true
+func (p *nodePool) try(ctx context.Context, nodes []*node, failover func(ctx context.Context, err error) bool,
+	fn func(n *node) error) (err error) {
+	if len(nodes) == 0 {
+		return errNoNode
+	}
+
+	for _, n := range nodes {
+		if err = fn(n); !failover(ctx, err) {
+			return err
+		}
+		p.Logger.Info("rpc node unreachable, failing over", "node", n.remote, "errMsg", err.Error())
+
+		p.mu.Lock()
+		n.checked = true
+		n.reachable = false
+		p.mu.Unlock()
+	}
+	return err
+}
+
+func (p *nodePool) query(ctx context.Context, fn func(c rpc.Client) error) error {
+	return p.try(ctx, p.balanced(), isTransportError, func(n *node) error { return fn(n.client) })
+}
+
+// broadcast only fails over when the tx could not be sent to the node, a node which may have
+// received the tx, such as on a read timeout, could have it in its mempool already
+func (p *nodePool) broadcast(ctx context.Context, fn func(c rpc.Client) error) error {
+	return p.try(ctx, p.preferred(), isConnectError, func(n *node) error { return fn(n.client) })
+}
+
+// isTransportError reports whether the request failed before the node could answer it,
+// the errors reported by the node are returned as a RPCError
+func isTransportError(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	var rpcErr *rpctypes.RPCError
+	return !errors.As(err, &rpcErr)
+}
+
+// isConnectError reports whether the connection to the node could not be established,
+// in which case the node has not received the request
+func isConnectError(ctx context.Context, err error) bool {
+	if !isTransportError(ctx, err) {
+		return false
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial"
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+// =============================================================================
+// ABCIClient
+
+func (p *nodePool) ABCIInfo(ctx context.Context) (res *ctypes.ResultABCIInfo, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.ABCIInfo(ctx); return })
+	return
+}
+
+func (p *nodePool) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (res *ctypes.ResultABCIQuery, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.ABCIQuery(ctx, path, data); return })
+	return
+}
+
+func (p *nodePool) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes,
+	opts rpc.ABCIQueryOptions) (res *ctypes.ResultABCIQuery, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.ABCIQueryWithOptions(ctx, path, data, opts); return })
+	return
+}
+
+func (p *nodePool) BroadcastTxCommit(ctx context.Context, tx tmtypes.Tx) (res *ctypes.ResultBroadcastTxCommit, err error) {
+	err = p.broadcast(ctx, func(c rpc.Client) (e error) { res, e = c.BroadcastTxCommit(ctx, tx); return })
+	return
+}
+
+func (p *nodePool) BroadcastTxAsync(ctx context.Context, tx tmtypes.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	err = p.broadcast(ctx, func(c rpc.Client) (e error) { res, e = c.BroadcastTxAsync(ctx, tx); return })
+	return
+}
+
+func (p *nodePool) BroadcastTxSync(ctx context.Context, tx tmtypes.Tx) (res *ctypes.ResultBroadcastTx, err error) {
+	err = p.broadcast(ctx, func(c rpc.Client) (e error) { res, e = c.BroadcastTxSync(ctx, tx); return })
+	return
+}
+
+// =============================================================================
+// EventsClient
+
+// Subscribe is not supported, the events are subscribed through the TmClient so that the
+// subscriptions survive the disconnections of the node
+func (p *nodePool) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	return nil, errSubscribeOnPool
+}
+
+func (p *nodePool) Unsubscribe(context.Context, string, string) error {
+	return errSubscribeOnPool
+}
+
+func (p *nodePool) UnsubscribeAll(context.Context, string) error {
+	return errSubscribeOnPool
+}
+
+// =============================================================================
+// HistoryClient
+
+func (p *nodePool) Genesis(ctx context.Context) (res *ctypes.ResultGenesis, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.Genesis(ctx); return })
+	return
+}
+
+func (p *nodePool) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (res *ctypes.ResultBlockchainInfo, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.BlockchainInfo(ctx, minHeight, maxHeight); return })
+	return
+}
+
+// =============================================================================
+// NetworkClient
+
+func (p *nodePool) NetInfo(ctx context.Context) (res *ctypes.ResultNetInfo, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.NetInfo(ctx); return })
+	return
+}
+
+func (p *nodePool) DumpConsensusState(ctx context.Context) (res *ctypes.ResultDumpConsensusState, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.DumpConsensusState(ctx); return })
+	return
+}
+
+func (p *nodePool) ConsensusState(ctx context.Context) (res *ctypes.ResultConsensusState, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.ConsensusState(ctx); return })
+	return
+}
+
+func (p *nodePool) ConsensusParams(ctx context.Context, height *int64) (res *ctypes.ResultConsensusParams, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.ConsensusParams(ctx, height); return })
+	return
+}
+
+func (p *nodePool) Health(ctx context.Context) (res *ctypes.ResultHealth, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.Health(ctx); return })
+	return
+}
+
+// =============================================================================
+// SignClient
+
+func (p *nodePool) Block(ctx context.Context, height *int64) (res *ctypes.ResultBlock, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.Block(ctx, height); return })
+	return
+}
+
+func (p *nodePool) BlockByHash(ctx context.Context, hash []byte) (res *ctypes.ResultBlock, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.BlockByHash(ctx, hash); return })
+	return
+}
+
+func (p *nodePool) BlockResults(ctx context.Context, height *int64) (res *ctypes.ResultBlockResults, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.BlockResults(ctx, height); return })
+	return
+}
+
+func (p *nodePool) Commit(ctx context.Context, height *int64) (res *ctypes.ResultCommit, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.Commit(ctx, height); return })
+	return
+}
+
+func (p *nodePool) Validators(ctx context.Context, height *int64, page, perPage *int) (res *ctypes.ResultValidators, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.Validators(ctx, height, page, perPage); return })
+	return
+}
+
+func (p *nodePool) Tx(ctx context.Context, hash []byte, prove bool) (res *ctypes.ResultTx, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.Tx(ctx, hash, prove); return })
+	return
+}
+
+func (p *nodePool) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int,
+	orderBy string) (res *ctypes.ResultTxSearch, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.TxSearch(ctx, query, prove, page, perPage, orderBy); return })
+	return
+}
+
+func (p *nodePool) BlockSearch(ctx context.Context, query string, page, perPage *int,
+	orderBy string) (res *ctypes.ResultBlockSearch, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.BlockSearch(ctx, query, page, perPage, orderBy); return })
+	return
+}
+
+// =============================================================================
+// StatusClient
+
+func (p *nodePool) Status(ctx context.Context) (res *ctypes.ResultStatus, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.Status(ctx); return })
+	return
+}
+
+// =============================================================================
+// EvidenceClient
+
+func (p *nodePool) BroadcastEvidence(ctx context.Context, ev tmtypes.Evidence) (res *ctypes.ResultBroadcastEvidence, err error) {
+	err = p.broadcast(ctx, func(c rpc.Client) (e error) { res, e = c.BroadcastEvidence(ctx, ev); return })
+	return
+}
+
+// =============================================================================
+// MempoolClient
+
+func (p *nodePool) UnconfirmedTxs(ctx context.Context, limit *int) (res *ctypes.ResultUnconfirmedTxs, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.UnconfirmedTxs(ctx, limit); return })
+	return
+}
+
+func (p *nodePool) NumUnconfirmedTxs(ctx context.Context) (res *ctypes.ResultUnconfirmedTxs, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.NumUnconfirmedTxs(ctx); return })
+	return
+}
+
+func (p *nodePool) CheckTx(ctx context.Context, tx tmtypes.Tx) (res *ctypes.ResultCheckTx, err error) {
+	err = p.query(ctx, func(c rpc.Client) (e error) { res, e = c.CheckTx(ctx, tx); return })
+	return
+}
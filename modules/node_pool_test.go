@@ -0,0 +1,140 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+type fakeNode struct {
+	rpc.Client
+	name       string
+	height     int64
+	catchingUp bool
+	err        error
+	calls      []string
+}
+
+func (f *fakeNode) Status(context.Context) (*ctypes.ResultStatus, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{
+		LatestBlockHeight: f.height,
+		CatchingUp:        f.catchingUp,
+	}}, nil
+}
+
+func (f *fakeNode) ABCIQuery(_ context.Context, path string, _ bytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	f.calls = append(f.calls, path)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ctypes.ResultABCIQuery{}, nil
+}
+
+func (f *fakeNode) BroadcastTxSync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	f.calls = append(f.calls, "broadcast")
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &ctypes.ResultBroadcastTx{}, nil
+}
+
+func TestNodePool(t *testing.T) {
+	a := &fakeNode{name: "a", height: 100}
+	b := &fakeNode{name: "b", height: 98}
+	lagging := &fakeNode{name: "lagging", height: 90}
+	catchingUp := &fakeNode{name: "catchingUp", height: 100, catchingUp: true}
+	fakes := []*fakeNode{a, b, lagging, catchingUp}
+
+	var nodes []*node
+	for i, f := range fakes {
+		nodes = append(nodes, &node{index: i, remote: f.name, client: f})
+	}
+	pool := newNodePool(nodes, time.Second, time.Second, 5, log.NewNopLogger())
+
+	var healthy []int
+	pool.onHealth = func(indexes []int) { healthy = indexes }
+	pool.checkHealth()
+	require.Equal(t, []int{0, 1}, healthy)
+
+	// the queries are balanced among the healthy nodes
+	ctx := context.Background()
+	for i := 0; i < 4; i++ {
+		_, err := pool.ABCIQuery(ctx, "/query", nil)
+		require.NoError(t, err)
+	}
+	require.Len(t, a.calls, 2)
+	require.Len(t, b.calls, 2)
+	require.Empty(t, lagging.calls)
+	require.Empty(t, catchingUp.calls)
+
+	// the broadcasts stick to the primary node
+	a.calls, b.calls = nil, nil
+	for i := 0; i < 3; i++ {
+		_, err := pool.BroadcastTxSync(ctx, nil)
+		require.NoError(t, err)
+	}
+	primary, other := a, b
+	if len(b.calls) > 0 {
+		primary, other = b, a
+	}
+	require.Len(t, primary.calls, 3)
+	require.Empty(t, other.calls)
+
+	// a node answering with an error is not failed over
+	primary.calls = nil
+	primary.err = &rpctypes.RPCError{Code: -32603, Message: "Internal error"}
+	_, err := pool.BroadcastTxSync(ctx, nil)
+	require.Error(t, err)
+	require.Len(t, primary.calls, 1)
+	require.Empty(t, other.calls)
+
+	// a node which may have received the tx is not failed over by a broadcast
+	primary.calls = nil
+	primary.err = fmt.Errorf("post failed: %w", &net.OpError{Op: "read", Net: "tcp", Err: errors.New("i/o timeout")})
+	_, err = pool.BroadcastTxSync(ctx, nil)
+	require.Error(t, err)
+	require.Len(t, primary.calls, 1)
+	require.Empty(t, other.calls)
+
+	// an unreachable node is failed over and avoided until it is healthy again
+	primary.calls = nil
+	primary.err = fmt.Errorf("post failed: %w", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED})
+	_, err = pool.BroadcastTxSync(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, primary.calls, 1)
+	require.Len(t, other.calls, 1)
+
+	_, err = pool.BroadcastTxSync(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, primary.calls, 1)
+	require.Len(t, other.calls, 2)
+
+	// the unhealthy nodes are the last resort
+	other.err = primary.err
+	lagging.calls, catchingUp.calls = nil, nil
+	_, err = pool.ABCIQuery(ctx, "/query", nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(lagging.calls)+len(catchingUp.calls))
+
+	primary.err, other.err = nil, nil
+	pool.checkHealth()
+	require.Equal(t, []int{0, 1}, healthy)
+	// the events are not subscribed on the nodes
+	_, err = pool.Subscribe(ctx, "subscriber", "tm.event = 'NewBlock'")
+	require.Error(t, err)
+}
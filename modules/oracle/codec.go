@@ -13,9 +13,19 @@ var (
 )
 
 func init() {
+	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 }
 
+// RegisterLegacyAminoCodec registers the necessary oracle interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateFeed{}, "irismod/oracle/MsgCreateFeed", nil)
+	cdc.RegisterConcrete(&MsgStartFeed{}, "irismod/oracle/MsgStartFeed", nil)
+	cdc.RegisterConcrete(&MsgPauseFeed{}, "irismod/oracle/MsgPauseFeed", nil)
+	cdc.RegisterConcrete(&MsgEditFeed{}, "irismod/oracle/MsgEditFeed", nil)
+}
+
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateFeed{},
@@ -0,0 +1,49 @@
+package oracle
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type CreateFeedEvent struct {
+	FeedName    string `event:"feed_name"`
+	ServiceName string `event:"service_name"`
+}
+
+func (CreateFeedEvent) EventType() string { return "create_feed" }
+
+type StartFeedEvent struct {
+	FeedName string `event:"feed_name"`
+}
+
+func (StartFeedEvent) EventType() string { return "start_feed" }
+
+type PauseFeedEvent struct {
+	FeedName string `event:"feed_name"`
+}
+
+func (PauseFeedEvent) EventType() string { return "pause_feed" }
+
+type EditFeedEvent struct {
+	FeedName string `event:"feed_name"`
+}
+
+func (EditFeedEvent) EventType() string { return "edit_feed" }
+
+// SetFeedEvent is emitted in the end block once the value of a feed is aggregated
+type SetFeedEvent struct {
+	FeedName  string `event:"feed_name"`
+	FeedValue string `event:"feed_value"`
+}
+
+func (SetFeedEvent) EventType() string { return "set_feed" }
+
+// RegisterEvents registers the typed events of the oracle module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		CreateFeedEvent{},
+		StartFeedEvent{},
+		PauseFeedEvent{},
+		EditFeedEvent{},
+		SetFeedEvent{},
+	)
+}
@@ -1,6 +1,8 @@
 package oracle
 
 import (
+	"context"
+
 	"time"
 
 	sdk "github.com/irisnet/irishub-sdk-go/types"
@@ -9,6 +11,7 @@ import (
 // expose Oracle module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	CreateFeed(request CreateFeedRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	StartFeed(feedName string, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
@@ -18,6 +21,7 @@ type Client interface {
 	QueryFeed(feedName string) (QueryFeedResp, sdk.Error)
 	QueryFeeds(state string) ([]QueryFeedResp, sdk.Error)
 	QueryFeedValue(feedName string) ([]QueryFeedValueResp, sdk.Error)
+	SubscribeEditFeedTx(feedName string, callback EventMsgEditFeedCallback) (sdk.Subscription, sdk.Error)
 }
 
 type CreateFeedRequest struct {
@@ -70,3 +74,5 @@ type QueryFeedValueResp struct {
 	Data      string    `json:"data"`
 	Timestamp time.Time `json:"timestamp"`
 }
+
+type EventMsgEditFeedCallback func(msg *MsgEditFeed, data sdk.EventDataMsg)
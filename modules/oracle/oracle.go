@@ -28,6 +28,15 @@ func (oc oracleClient) RegisterInterfaceTypes(registry types.InterfaceRegistry)
 	RegisterInterfaces(registry)
 }
 
+func (oc oracleClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (oc oracleClient) WithContext(ctx context.Context) Client {
+	return NewClient(oc.BaseClient.WithContext(ctx), oc.Marshaler)
+}
+
 func (oc oracleClient) CreateFeed(request CreateFeedRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	sender, err := oc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
@@ -114,13 +123,12 @@ func (oc oracleClient) QueryFeed(feedName string) (QueryFeedResp, sdk.Error) {
 	}
 
 	conn, err := oc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryFeedResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Feed(
-		context.Background(),
+		oc.Context(),
 		&QueryFeedRequest{FeedName: feedName},
 	)
 	if err != nil {
@@ -136,13 +144,12 @@ func (oc oracleClient) QueryFeeds(state string) ([]QueryFeedResp, sdk.Error) {
 	}
 
 	conn, err := oc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Feeds(
-		context.Background(),
+		oc.Context(),
 		&QueryFeedsRequest{State: state},
 	)
 	if err != nil {
@@ -157,13 +164,12 @@ func (oc oracleClient) QueryFeedValue(feedName string) ([]QueryFeedValueResp, sd
 	}
 
 	conn, err := oc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).FeedValue(
-		context.Background(),
+		oc.Context(),
 		&QueryFeedValueRequest{FeedName: feedName},
 	)
 	if err != nil {
@@ -171,3 +177,15 @@ func (oc oracleClient) QueryFeedValue(feedName string) ([]QueryFeedValueResp, sd
 	}
 	return feedValues(res.FeedValues).Convert().([]QueryFeedValueResp), nil
 }
+
+// SubscribeEditFeedTx subscribes the changes of the feed, an empty feed name matches any
+func (oc oracleClient) SubscribeEditFeedTx(feedName string, callback EventMsgEditFeedCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgEditFeed{}.Type())
+	return sdk.SubscribeMsgs(oc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgEditFeed)
+		if !ok || (len(feedName) > 0 && msg.FeedName != feedName) {
+			return
+		}
+		callback(msg, data)
+	})
+}
@@ -0,0 +1,26 @@
+package random
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type RequestRandomEvent struct {
+	RequestID      string `event:"request_id,required"`
+	Consumer       string `event:"consumer"`
+	GenerateHeight int64  `event:"generate_height,required"`
+}
+
+func (RequestRandomEvent) EventType() string { return eventTypeRequestRequestRandom }
+
+// GenerateRandomEvent is emitted in the begin block once the random number of a request is generated
+type GenerateRandomEvent struct {
+	RequestID string `event:"request_id"`
+	Random    string `event:"random"`
+}
+
+func (GenerateRandomEvent) EventType() string { return "generate_random" }
+
+// RegisterEvents registers the typed events of the random module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(RequestRandomEvent{}, GenerateRandomEvent{})
+}
@@ -1,15 +1,21 @@
 package random
 
-import sdk "github.com/irisnet/irishub-sdk-go/types"
+import (
+	"context"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
 
 // expose Random module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	RequestRandom(request RequestRandomRequest, basTx sdk.BaseTx) (RequestRandomResp, sdk.ResultTx, sdk.Error)
 
 	QueryRandom(ReqId string) (QueryRandomResp, sdk.Error)
 	QueryRandomRequestQueue(height int64) ([]QueryRandomRequestQueueResp, sdk.Error)
+	SubscribeRequestRandomTx(consumer string, callback EventMsgRequestRandomCallback) (sdk.Subscription, sdk.Error)
 }
 
 type RequestRandomRequest struct {
@@ -37,3 +43,5 @@ type QueryRandomRequestQueueResp struct {
 	ServiceFeeCap    sdk.Coins `json:"service_fee_cap" yaml:"service_fee_cap"`
 	ServiceContextId string    `json:"service_context_id" yaml:"service_context_id"`
 }
+
+type EventMsgRequestRandomCallback func(msg *MsgRequestRandom, data sdk.EventDataMsg)
@@ -2,7 +2,6 @@ package random
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
 	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
@@ -29,6 +28,15 @@ func (rc randomClient) RegisterInterfaceTypes(registry cdctypes.InterfaceRegistr
 	RegisterInterfaces(registry)
 }
 
+func (rc randomClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (rc randomClient) WithContext(ctx context.Context) Client {
+	return NewClient(rc.BaseClient.WithContext(ctx), rc.Marshaler)
+}
+
 func (rc randomClient) RequestRandom(request RequestRandomRequest, basTx sdk.BaseTx) (RequestRandomResp, sdk.ResultTx, sdk.Error) {
 	author, err := rc.QueryAddress(basTx.From, basTx.Password)
 	if err != nil {
@@ -46,22 +54,14 @@ func (rc randomClient) RequestRandom(request RequestRandomRequest, basTx sdk.Bas
 		return RequestRandomResp{}, sdk.ResultTx{}, err
 	}
 
-	reqID, e := result.Events.GetValue(eventTypeRequestRequestRandom, attributeKeyRequestID)
-	if e != nil {
-		return RequestRandomResp{}, result, sdk.Wrap(e)
-	}
-	generateHeight, e := result.Events.GetValue(eventTypeRequestRequestRandom, attributeKeyGenerateHeight)
-	if e != nil {
-		return RequestRandomResp{}, result, sdk.Wrap(e)
-	}
-	height, e := strconv.Atoi(generateHeight)
-	if e != nil {
+	var event RequestRandomEvent
+	if e := sdk.DecodeEvent(result.Events, &event); e != nil {
 		return RequestRandomResp{}, result, sdk.Wrap(e)
 	}
 
 	res := RequestRandomResp{
-		Height: int64(height),
-		ReqID:  reqID,
+		Height: event.GenerateHeight,
+		ReqID:  event.RequestID,
 	}
 	return res, result, nil
 }
@@ -72,13 +72,12 @@ func (rc randomClient) QueryRandom(reqID string) (QueryRandomResp, sdk.Error) {
 	}
 
 	conn, err := rc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryRandomResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Random(
-		context.Background(),
+		rc.Context(),
 		&QueryRandomRequest{ReqId: reqID},
 	)
 	if err != nil {
@@ -93,12 +92,11 @@ func (rc randomClient) QueryRandomRequestQueue(height int64) ([]QueryRandomReque
 	}
 
 	conn, err := rc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return []QueryRandomRequestQueueResp{}, sdk.Wrap(err)
 	}
 	res, err := NewQueryClient(conn).RandomRequestQueue(
-		context.Background(),
+		rc.Context(),
 		&QueryRandomRequestQueueRequest{Height: height},
 	)
 	if err != nil {
@@ -106,3 +104,16 @@ func (rc randomClient) QueryRandomRequestQueue(height int64) ([]QueryRandomReque
 	}
 	return Requests(res.Requests).Convert().([]QueryRandomRequestQueueResp), nil
 }
+
+// SubscribeRequestRandomTx subscribes the random numbers requested by the consumer, an empty consumer matches any
+func (rc randomClient) SubscribeRequestRandomTx(consumer string, callback EventMsgRequestRandomCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgRequestRandom{}.Type())
+	if len(consumer) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(consumer)))
+	}
+	return sdk.SubscribeMsgs(rc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		if msg, ok := data.Msg.(*MsgRequestRandom); ok {
+			callback(msg, data)
+		}
+	})
+}
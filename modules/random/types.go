@@ -8,8 +8,6 @@ const (
 	ModuleName = "random"
 
 	eventTypeRequestRequestRandom = "request_random"
-	attributeKeyRequestID         = "request_id"
-	attributeKeyGenerateHeight    = "generate_height"
 )
 
 var (
@@ -0,0 +1,17 @@
+package record
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type CreateRecordEvent struct {
+	Creator  string `event:"creator"`
+	RecordID string `event:"record_id,required"`
+}
+
+func (CreateRecordEvent) EventType() string { return eventTypeCreateRecord }
+
+// RegisterEvents registers the typed events of the record module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(CreateRecordEvent{})
+}
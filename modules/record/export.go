@@ -1,15 +1,19 @@
 package record
 
 import (
+	"context"
+
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
 // expose Record module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	CreateRecord(request CreateRecordRequest, baseTx sdk.BaseTx) (string, sdk.Error)
 	QueryRecord(request QueryRecordReq) (QueryRecordResp, sdk.Error)
+	SubscribeCreateRecordTx(creator string, callback EventMsgCreateRecordCallback) (sdk.Subscription, sdk.Error)
 }
 
 type CreateRecordRequest struct {
@@ -33,3 +37,5 @@ type Data struct {
 	Contents []Content `json:"contents" yaml:"contents"`
 	Creator  string    `json:"creator" yaml:"creator"`
 }
+
+type EventMsgCreateRecordCallback func(msg *MsgCreateRecord, data sdk.EventDataMsg)
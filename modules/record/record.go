@@ -1,6 +1,7 @@
 package record
 
 import (
+	"context"
 	"encoding/hex"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
@@ -28,6 +29,15 @@ func (r recordClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 	RegisterInterfaces(registry)
 }
 
+func (r recordClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (r recordClient) WithContext(ctx context.Context) Client {
+	return NewClient(r.BaseClient.WithContext(ctx), r.Marshaler)
+}
+
 func (r recordClient) CreateRecord(request CreateRecordRequest, baseTx sdk.BaseTx) (string, sdk.Error) {
 	creator, err := r.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
@@ -44,12 +54,12 @@ func (r recordClient) CreateRecord(request CreateRecordRequest, baseTx sdk.BaseT
 		return "", err
 	}
 
-	recordID, er := res.Events.GetValue(eventTypeCreateRecord, attributeKeyRecordID)
-	if er != nil {
+	var event CreateRecordEvent
+	if er := sdk.DecodeEvent(res.Events, &event); er != nil {
 		return "", sdk.Wrap(er)
 	}
 
-	return recordID, nil
+	return event.RecordID, nil
 }
 
 func (r recordClient) QueryRecord(request QueryRecordReq) (QueryRecordResp, sdk.Error) {
@@ -85,3 +95,16 @@ func (r recordClient) QueryRecord(request QueryRecordReq) (QueryRecordResp, sdk.
 	result.Height = res.Height
 	return result, nil
 }
+
+// SubscribeCreateRecordTx subscribes the records created by the creator, an empty creator matches any
+func (r recordClient) SubscribeCreateRecordTx(creator string, callback EventMsgCreateRecordCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgCreateRecord{}.Type())
+	if len(creator) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(creator)))
+	}
+	return sdk.SubscribeMsgs(r.BaseClient, builder, func(data sdk.EventDataMsg) {
+		if msg, ok := data.Msg.(*MsgCreateRecord); ok {
+			callback(msg, data)
+		}
+	})
+}
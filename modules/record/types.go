@@ -9,7 +9,6 @@ import (
 const (
 	ModuleName = "record"
 
-	attributeKeyRecordID  = "record_id"
 	eventTypeCreateRecord = "create_record"
 )
 
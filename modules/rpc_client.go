@@ -3,11 +3,13 @@ package modules
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 	rpc "github.com/tendermint/tendermint/rpc/client"
-	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
@@ -20,6 +22,8 @@ type rpcClient struct {
 	log.Logger
 	cdc       *codec.LegacyAmino
 	txDecoder sdk.TxDecoder
+	subs      *subscriptions
+	delivery  sdk.DeliveryOptions
 }
 
 func NewRPCClient(
@@ -29,17 +33,20 @@ func NewRPCClient(
 	logger log.Logger,
 	timeout uint,
 ) sdk.TmClient {
-	client, err := rpchttp.NewWithTimeout(remote, "/websocket", timeout)
-	if err != nil {
-		panic(err)
-	}
+	pool := newNodePool(dialNodes([]string{remote}, timeout, logger), 0, time.Duration(timeout)*time.Second, 0, logger)
+	_ = pool.Start()
+	return newRPCClient(pool, cdc, txDecoder, logger, newSubscriptions(pool, false, nil, logger), sdk.DeliveryOptions{})
+}
 
-	_ = client.Start()
+func newRPCClient(pool *nodePool, cdc *codec.LegacyAmino, txDecoder sdk.TxDecoder, logger log.Logger,
+	subs *subscriptions, delivery sdk.DeliveryOptions) sdk.TmClient {
 	return rpcClient{
-		Client:    client,
+		Client:    pool,
 		Logger:    logger,
 		cdc:       cdc,
 		txDecoder: txDecoder,
+		subs:      subs,
+		delivery:  delivery.WithDefaults(),
 	}
 }
 
@@ -51,9 +58,10 @@ func (r rpcClient) SubscribeNewBlock(builder *sdk.EventQueryBuilder, handler sdk
 	}
 
 	builder.AddCondition(sdk.Cond(sdk.TypeKey).EQ(tmtypes.EventNewBlock))
-	query := builder.Build()
-
-	return r.SubscribeAny(query, func(data sdk.EventData) {
+	key := func(data sdk.EventData) string {
+		return strconv.FormatInt(data.(sdk.EventDataNewBlock).Block.Height, 10)
+	}
+	return r.subscribeQueries(builder, key, func(data sdk.EventData) {
 		handler(data.(sdk.EventDataNewBlock))
 	})
 }
@@ -63,12 +71,53 @@ func (r rpcClient) SubscribeTx(builder *sdk.EventQueryBuilder, handler sdk.Event
 	if builder == nil {
 		builder = sdk.NewEventQueryBuilder()
 	}
-	query := builder.AddCondition(sdk.Cond(sdk.TypeKey).EQ(sdk.TxValue)).Build()
-	return r.SubscribeAny(query, func(data sdk.EventData) {
+	builder.AddCondition(sdk.Cond(sdk.TypeKey).EQ(sdk.TxValue))
+	key := func(data sdk.EventData) string {
+		return data.(sdk.EventDataTx).Hash
+	}
+	return r.subscribeQueries(builder, key, func(data sdk.EventData) {
 		handler(data.(sdk.EventDataTx))
 	})
 }
 
+// subscribeQueries subscribes to every query of the builder, the events matched by several
+// queries of an OR query are passed once to the handler, in the order they are received
+func (r rpcClient) subscribeQueries(builder *sdk.EventQueryBuilder, key func(data sdk.EventData) string,
+	handler sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+	if err := builder.Err(); err != nil {
+		return sdk.Subscription{}, sdk.Wrap(err)
+	}
+
+	queries := builder.Queries()
+	if len(queries) <= 1 {
+		return r.SubscribeAny(builder.Build(), handler)
+	}
+
+	seen := newRecentKeys(recentKeysSize)
+	var subscriptions []sdk.Subscription
+	for _, query := range queries {
+		subscription, err := r.SubscribeAny(query, func(data sdk.EventData) {
+			if seen.add(key(data)) {
+				handler(data)
+			}
+		})
+		if err != nil {
+			for _, s := range subscriptions {
+				_ = r.Unsubscribe(s)
+			}
+			return sdk.Subscription{}, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return sdk.Subscription{
+		Ctx:           subscriptions[0].Ctx,
+		Query:         strings.Join(queries, " OR "),
+		ID:            subscriptions[0].ID,
+		Subscriptions: subscriptions,
+	}, nil
+}
+
 func (r rpcClient) SubscribeNewBlockHeader(handler sdk.EventNewBlockHeaderHandler) (sdk.Subscription, sdk.Error) {
 	query := tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
 	return r.SubscribeAny(query, func(data sdk.EventData) {
@@ -89,8 +138,18 @@ func (r rpcClient) Resubscribe(subscription sdk.Subscription, handler sdk.EventH
 }
 
 func (r rpcClient) Unsubscribe(subscription sdk.Subscription) sdk.Error {
+	if len(subscription.Subscriptions) > 0 {
+		var err sdk.Error
+		for _, s := range subscription.Subscriptions {
+			if e := r.Unsubscribe(s); e != nil && err == nil {
+				err = e
+			}
+		}
+		return err
+	}
+
 	r.Info("end to subscribe event", "query", subscription.Query, "subscriber", subscription.ID)
-	err := r.Client.Unsubscribe(subscription.Ctx, subscription.ID, subscription.Query)
+	err := r.subs.unsubscribe(subscription.Ctx, subscription.ID)
 	if err != nil {
 		r.Error("unsubscribe failed", "query", subscription.Query, "subscriber", subscription.ID, "errMsg", err.Error())
 		return sdk.Wrap(err)
@@ -98,62 +157,97 @@ func (r rpcClient) Unsubscribe(subscription sdk.Subscription) sdk.Error {
 	return nil
 }
 
+// SubscribeAny subscribes to the query with the delivery options of the config
 func (r rpcClient) SubscribeAny(query string, handler sdk.EventHandler) (subscription sdk.Subscription, err sdk.Error) {
-	ctx := context.Background()
-	subscriber := getSubscriber()
-	ch, e := r.Subscribe(ctx, subscriber, query, 0)
-	if e != nil {
-		return subscription, sdk.Wrap(e)
+	return r.SubscribeWithOptions(query, r.delivery, handler)
+}
+
+// SubscribeWithOptions subscribes to the query, the events are queued and passed to the handler as
+// specified by opts. The subscription survives the disconnections of the node.
+func (r rpcClient) SubscribeWithOptions(query string, opts sdk.DeliveryOptions, handler sdk.EventHandler) (sdk.Subscription, sdk.Error) {
+	subscription := r.newSubscription(query)
+	d := newDispatcher(opts, func(data sdk.EventData) {
+		defer sdk.CatchPanic(func(errMsg string) {
+			r.Error("handle event failed", "query", subscription.Query, "subscriber", subscription.ID, "errMsg", errMsg)
+		})
+		handler(data)
+	}, r.overflowed(subscription))
+
+	if err := r.subscribe(subscription, d); err != nil {
+		return sdk.Subscription{}, err
 	}
+	return subscription, nil
+}
+
+// SubscribeChan subscribes to the query, the events are queued in the returned channel as specified
+// by opts. The channel is closed when the subscription is cancelled.
+func (r rpcClient) SubscribeChan(query string, opts sdk.DeliveryOptions) (sdk.Subscription, <-chan sdk.EventData, sdk.Error) {
+	subscription := r.newSubscription(query)
+	d, ch := newChanDispatcher(opts, r.overflowed(subscription))
 
-	r.Info("subscribe event", "query", query, "subscriber", subscription.ID)
+	if err := r.subscribe(subscription, d); err != nil {
+		return sdk.Subscription{}, nil, err
+	}
+	return subscription, ch, nil
+}
 
-	subscription = sdk.Subscription{
-		Ctx:   ctx,
+func (r rpcClient) newSubscription(query string) sdk.Subscription {
+	return sdk.Subscription{
+		Ctx:   context.Background(),
 		Query: query,
-		ID:    subscriber,
-	}
-
-	go func() {
-		for {
-			data := <-ch
-			go func() {
-				defer sdk.CatchPanic(func(errMsg string) {
-					r.Error("unsubscribe failed", "query", subscription.Query, "subscriber", subscription.ID, "errMsg", err.Error())
-				})
-
-				switch data := data.Data.(type) {
-				case tmtypes.EventDataTx:
-					handler(r.parseTx(data))
-					return
-				case tmtypes.EventDataNewBlock:
-					handler(r.parseNewBlock(data))
-					return
-				case tmtypes.EventDataNewBlockHeader:
-					handler(r.parseNewBlockHeader(data))
-					return
-				case tmtypes.EventDataValidatorSetUpdates:
-					handler(r.parseValidatorSetUpdates(data))
-					return
-				default:
-					handler(data)
-				}
-			}()
-		}
-	}()
-	return
+		ID:    getSubscriber(),
+	}
+}
+
+func (r rpcClient) subscribe(subscription sdk.Subscription, d *dispatcher) sdk.Error {
+	err := r.subs.subscribe(subscription.Ctx, subscription.ID, subscription.Query, func(data tmtypes.TMEventData) {
+		d.dispatch(r.parseEvent(data))
+	}, d.close)
+	if err != nil {
+		d.close()
+		return sdk.Wrap(err)
+	}
+
+	r.Info("subscribe event", "query", subscription.Query, "subscriber", subscription.ID)
+	return nil
+}
+
+// overflowed cancels the subscription whose queue is full under the OverflowError policy
+func (r rpcClient) overflowed(subscription sdk.Subscription) func(err error) {
+	return func(err error) {
+		r.Error("event queue overflowed", "query", subscription.Query, "subscriber", subscription.ID)
+		_ = r.Unsubscribe(subscription)
+		r.subs.notify(sdk.SubscriptionEvent{Type: sdk.SubscriptionOverflowed, Query: subscription.Query, Error: err})
+	}
 }
 
+func (r rpcClient) parseEvent(data tmtypes.TMEventData) sdk.EventData {
+	switch data := data.(type) {
+	case tmtypes.EventDataTx:
+		return r.parseTx(data)
+	case tmtypes.EventDataNewBlock:
+		return r.parseNewBlock(data)
+	case tmtypes.EventDataNewBlockHeader:
+		return r.parseNewBlockHeader(data)
+	case tmtypes.EventDataValidatorSetUpdates:
+		return r.parseValidatorSetUpdates(data)
+	default:
+		return data
+	}
+}
+
+// parseTx parses the tx event, a tx which can't be decoded is logged and passed with a nil Tx
 func (r rpcClient) parseTx(data sdk.EventData) sdk.EventDataTx {
 	dataTx := data.(tmtypes.EventDataTx)
+	hash := sdk.HexBytes(tmhash.Sum(dataTx.Tx)).String()
 	tx, err := r.txDecoder(dataTx.Tx)
 	if err != nil {
-		return sdk.EventDataTx{}
+		r.Error("decode tx failed", "hash", hash, "height", dataTx.Height, "errMsg", err.Error())
 	}
 
-	hash := sdk.HexBytes(tmhash.Sum(dataTx.Tx)).String()
 	result := sdk.TxResult{
 		Code:      dataTx.Result.Code,
+		Codespace: dataTx.Result.Codespace,
 		Log:       dataTx.Result.Log,
 		GasWanted: dataTx.Result.GasWanted,
 		GasUsed:   dataTx.Result.GasUsed,
@@ -0,0 +1,36 @@
+package modules
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// the txs which can't be decoded keep their own hash, so that the OR subscriptions don't
+// drop them as duplicates of each other
+func TestParseUndecodableTx(t *testing.T) {
+	r := rpcClient{
+		Logger:    log.NewNopLogger(),
+		txDecoder: func([]byte) (sdk.Tx, error) { return nil, errors.New("undecodable") },
+	}
+
+	seen := newRecentKeys(recentKeysSize)
+	for _, bz := range [][]byte{{1}, {2}} {
+		tx := r.parseTx(tmtypes.EventDataTx{TxResult: abci.TxResult{
+			Height: 10,
+			Tx:     bz,
+			Result: abci.ResponseDeliverTx{Code: 5, Codespace: "sdk"},
+		}})
+		require.Nil(t, tx.Tx)
+		require.NotEmpty(t, tx.Hash)
+		require.EqualValues(t, 10, tx.Height)
+		require.EqualValues(t, 5, tx.Result.Code)
+		require.True(t, seen.add(tx.Hash))
+	}
+}
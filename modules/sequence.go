@@ -0,0 +1,278 @@
+package modules
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// maxSequenceRetries is the maximum number of times a tx is rebuilt with a new sequence
+const maxSequenceRetries = 10
+
+var sequenceMismatchRegexp = regexp.MustCompile(`account sequence mismatch, expected (\d+), got (\d+)`)
+
+// sequenceManager hands out monotonically increasing sequences of an account to the
+// concurrent senders. The sequences are tracked locally and the holder of a sequence
+// keeps the account until the tx is accepted by CheckTx, so that the txs of an account
+// reach the node in the order of their sequences. The accepted txs waiting to be
+// committed stay in flight without holding the account. The local sequence is resynced
+// when a tx is rejected with a sequence mismatch.
+type sequenceManager struct {
+	mu       sync.Mutex
+	accounts map[string]*accountSequence
+	query    func(ctx context.Context, address string) (sdk.BaseAccount, sdk.Error)
+	logger   log.Logger
+}
+
+type accountSequence struct {
+	// held by the sender of the tx with the next sequence
+	lock          chan struct{}
+	accountNumber uint64
+	next          uint64
+	synced        bool
+
+	// guarded by sequenceManager.mu
+	inFlight map[uint64]struct{}
+	metrics  sdk.SequenceMetrics
+}
+
+// sequenceTicket is a sequence handed out to a sender, it must be either released or resynced
+type sequenceTicket struct {
+	address       string
+	accountNumber uint64
+	sequence      uint64
+}
+
+func newSequenceManager(query func(ctx context.Context, address string) (sdk.BaseAccount, sdk.Error), logger log.Logger) *sequenceManager {
+	return &sequenceManager{
+		accounts: make(map[string]*accountSequence),
+		query:    query,
+		logger:   logger,
+	}
+}
+
+// acquire waits for the account to be free and hands out its next sequence, the sequence
+// is queried from the chain if it is not tracked yet. The wait is aborted when ctx is done.
+func (m *sequenceManager) acquire(ctx context.Context, address string) (sequenceTicket, error) {
+	acc := m.account(address)
+	m.update(acc, func(metrics *sdk.SequenceMetrics) { metrics.Pending++ })
+
+	select {
+	case acc.lock <- struct{}{}:
+	case <-ctx.Done():
+		m.update(acc, func(metrics *sdk.SequenceMetrics) { metrics.Pending-- })
+		return sequenceTicket{}, ctx.Err()
+	}
+
+	if !acc.synced {
+		account, err := m.query(ctx, address)
+		if err != nil {
+			<-acc.lock
+			m.update(acc, func(metrics *sdk.SequenceMetrics) { metrics.Pending-- })
+			return sequenceTicket{}, err
+		}
+		acc.accountNumber = account.AccountNumber
+		acc.next = account.Sequence
+		acc.synced = true
+		m.logger.Debug("sync account sequence", "address", address, "sequence", acc.next)
+	}
+
+	return sequenceTicket{
+		address:       address,
+		accountNumber: acc.accountNumber,
+		sequence:      acc.next,
+	}, nil
+}
+
+// acquireAll acquires the sequences of the signers of a tx and returns their tickets in the order of
+// the addresses. The accounts are acquired in the order of their addresses, so that the senders sharing
+// a fee payer don't deadlock.
+func (m *sequenceManager) acquireAll(ctx context.Context, addresses ...string) ([]sequenceTicket, error) {
+	order := make([]int, len(addresses))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return addresses[order[i]] < addresses[order[j]] })
+
+	tickets := make([]sequenceTicket, len(addresses))
+	for n, i := range order {
+		ticket, err := m.acquire(ctx, addresses[i])
+		if err != nil {
+			for _, j := range order[:n] {
+				m.release(tickets[j], false)
+			}
+			return nil, err
+		}
+		tickets[i] = ticket
+	}
+	return tickets, nil
+}
+
+// release frees the account, the sequence is handed out again unless it has been used
+func (m *sequenceManager) release(t sequenceTicket, used bool) {
+	acc := m.account(t.address)
+	if used {
+		acc.next = t.sequence + 1
+	}
+	m.update(acc, func(metrics *sdk.SequenceMetrics) {
+		metrics.Pending--
+		metrics.Next = acc.next
+	})
+	<-acc.lock
+}
+
+// accept frees the account once the tx with the sequence of the ticket has been accepted by CheckTx,
+// the sequence is used and stays in flight until the tx is settled
+func (m *sequenceManager) accept(t sequenceTicket) {
+	acc := m.account(t.address)
+	m.update(acc, func(metrics *sdk.SequenceMetrics) {
+		acc.inFlight[t.sequence] = struct{}{}
+		metrics.InFlight = len(acc.inFlight)
+	})
+	m.release(t, true)
+}
+
+// settle removes the accepted sequence of the ticket from the ones in flight. A tx which is not known to
+// be committed may have been dropped from the mempool, the sequence is then queried from the chain again.
+func (m *sequenceManager) settle(t sequenceTicket, committed bool) {
+	acc := m.account(t.address)
+	m.update(acc, func(metrics *sdk.SequenceMetrics) {
+		delete(acc.inFlight, t.sequence)
+		metrics.InFlight = len(acc.inFlight)
+	})
+	if committed {
+		return
+	}
+
+	acc.lock <- struct{}{}
+	acc.synced = false
+	m.logger.Info("tx not committed, resync account sequence", "address", t.address, "sequence", t.sequence)
+	<-acc.lock
+}
+
+// resync frees the account after the tx with the sequence of the ticket has been rejected with
+// a sequence mismatch. The next sequence is the one expected by the node if the log reports it,
+// otherwise it is queried from the chain on the next acquire.
+func (m *sequenceManager) resync(t sequenceTicket, errLog string) {
+	acc := m.account(t.address)
+	expected, _, ok := parseSequenceMismatch(errLog)
+	if ok {
+		acc.next = expected
+	} else {
+		acc.synced = false
+	}
+	m.logger.Info("resync account sequence", "address", t.address, "sequence", t.sequence, "expected", expected)
+
+	m.update(acc, func(metrics *sdk.SequenceMetrics) {
+		metrics.Pending--
+		metrics.Resyncs++
+		metrics.Next = acc.next
+		// the sequences between the expected and the rejected one were handed
+		// out, but their txs never made it into the mempool
+		if ok && expected < t.sequence {
+			metrics.Gaps += t.sequence - expected
+		}
+	})
+	<-acc.lock
+}
+
+// resyncAll frees the accounts of the signers of a tx rejected with a sequence mismatch, the tickets
+// are in the order of the signers of the tx. The node reports the first signer whose sequence doesn't
+// match, so only the account of the first ticket with the sequence the node got is resynced and the
+// others are released unused. When that sequence was handed out for several signers the account can't
+// be told, their sequences are queried from the chain again.
+func (m *sequenceManager) resyncAll(tickets []sequenceTicket, errLog string) {
+	var mismatched []int
+	if _, got, ok := parseSequenceMismatch(errLog); ok {
+		for i, t := range tickets {
+			if t.sequence == got {
+				mismatched = append(mismatched, i)
+			}
+		}
+	}
+	if len(mismatched) == 0 {
+		mismatched = []int{0}
+	}
+	if len(mismatched) > 1 {
+		errLog = ""
+	}
+
+	for i, t := range tickets {
+		if len(mismatched) > 0 && mismatched[0] == i {
+			mismatched = mismatched[1:]
+			m.resync(t, errLog)
+		} else {
+			m.release(t, false)
+		}
+	}
+}
+
+// retried records that a tx of the account is rebuilt with a new sequence
+func (m *sequenceManager) retried(address string) {
+	m.update(m.account(address), func(metrics *sdk.SequenceMetrics) { metrics.Retries++ })
+}
+
+// metrics returns the metrics of the sequences of the account
+func (m *sequenceManager) metrics(address string) sdk.SequenceMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acc, ok := m.accounts[address]
+	if !ok {
+		return sdk.SequenceMetrics{}
+	}
+	return acc.metrics
+}
+
+func (m *sequenceManager) account(address string) *accountSequence {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	acc, ok := m.accounts[address]
+	if !ok {
+		acc = &accountSequence{
+			lock:     make(chan struct{}, 1),
+			inFlight: make(map[uint64]struct{}),
+		}
+		m.accounts[address] = acc
+	}
+	return acc
+}
+
+func (m *sequenceManager) update(acc *accountSequence, fn func(metrics *sdk.SequenceMetrics)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	fn(&acc.metrics)
+}
+
+// isSequenceMismatch reports whether the tx has been rejected because of its sequence
+func isSequenceMismatch(err sdk.Error) bool {
+	if err == nil {
+		return false
+	}
+	return err.Code() == uint32(sdk.InvalidSequence) || sequenceMismatchRegexp.MatchString(err.Error())
+}
+
+// parseSequenceMismatch returns the sequence expected by the node and the one it got from the log of the CheckTx
+func parseSequenceMismatch(errLog string) (expected, got uint64, ok bool) {
+	matches := sequenceMismatchRegexp.FindStringSubmatch(errLog)
+	if len(matches) != 3 {
+		return 0, 0, false
+	}
+
+	expected, err := strconv.ParseUint(matches[1], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	got, err = strconv.ParseUint(matches[2], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return expected, got, true
+}
@@ -0,0 +1,176 @@
+package modules
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+func TestSequenceManager(t *testing.T) {
+	var queries int
+	chainSequence := uint64(5)
+	m := newSequenceManager(func(_ context.Context, address string) (sdk.BaseAccount, sdk.Error) {
+		queries++
+		return sdk.BaseAccount{Address: address, AccountNumber: 1, Sequence: chainSequence}, nil
+	}, log.NewNopLogger())
+
+	// the concurrent senders get distinct and increasing sequences
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var sequences []uint64
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ticket, err := m.acquire(context.Background(), "addr")
+			require.NoError(t, err)
+			mu.Lock()
+			sequences = append(sequences, ticket.sequence)
+			mu.Unlock()
+			m.release(ticket, true)
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, sequences, 20)
+	for i, seq := range sequences {
+		require.Equal(t, uint64(5+i), seq)
+	}
+	require.Equal(t, 1, queries)
+	require.Equal(t, sdk.SequenceMetrics{Next: 25}, m.metrics("addr"))
+
+	// an unused sequence is handed out again
+	ticket, err := m.acquire(context.Background(), "addr")
+	require.NoError(t, err)
+	m.release(ticket, false)
+	ticket, err = m.acquire(context.Background(), "addr")
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), ticket.sequence)
+
+	// the node expects an earlier sequence, the txs in between have been lost
+	errLog := "account sequence mismatch, expected 22, got 25: incorrect account sequence"
+	require.True(t, isSequenceMismatch(sdk.Wrapf(errLog)))
+	m.resync(ticket, errLog)
+	m.retried("addr")
+
+	ticket, err = m.acquire(context.Background(), "addr")
+	require.NoError(t, err)
+	require.Equal(t, uint64(22), ticket.sequence)
+
+	// the sequence is queried from the chain when the log doesn't report it
+	chainSequence = 30
+	m.resync(ticket, "unauthorized")
+	ticket, err = m.acquire(context.Background(), "addr")
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), ticket.sequence)
+	require.Equal(t, 2, queries)
+
+	// a sender waiting for the account gives up when its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = m.acquire(ctx, "addr")
+	require.Equal(t, context.Canceled, err)
+	m.release(ticket, true)
+
+	require.Equal(t, sdk.SequenceMetrics{Next: 31, Retries: 1, Resyncs: 2, Gaps: 3}, m.metrics("addr"))
+	require.False(t, isSequenceMismatch(sdk.Wrapf("insufficient fees")))
+}
+
+func TestSequenceManagerInFlight(t *testing.T) {
+	var queries int
+	m := newSequenceManager(func(_ context.Context, address string) (sdk.BaseAccount, sdk.Error) {
+		queries++
+		return sdk.BaseAccount{Address: address, AccountNumber: 1, Sequence: 5}, nil
+	}, log.NewNopLogger())
+
+	// the account is freed once the tx is accepted, while it waits to be committed
+	first, err := m.acquire(context.Background(), "addr")
+	require.NoError(t, err)
+	m.accept(first)
+	require.Equal(t, sdk.SequenceMetrics{Next: 6, InFlight: 1}, m.metrics("addr"))
+
+	second, err := m.acquire(context.Background(), "addr")
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), second.sequence)
+	m.accept(second)
+	require.Equal(t, 2, m.metrics("addr").InFlight)
+
+	m.settle(first, true)
+	require.Equal(t, sdk.SequenceMetrics{Next: 7, InFlight: 1}, m.metrics("addr"))
+
+	// a tx not known to be committed makes the sequence be queried again
+	m.settle(second, false)
+	ticket, err := m.acquire(context.Background(), "addr")
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), ticket.sequence)
+	require.Equal(t, 2, queries)
+	m.release(ticket, false)
+	require.Equal(t, 0, m.metrics("addr").InFlight)
+}
+
+func TestSequenceManagerFeePayer(t *testing.T) {
+	m := newSequenceManager(func(_ context.Context, address string) (sdk.BaseAccount, sdk.Error) {
+		return sdk.BaseAccount{Address: address, AccountNumber: 1, Sequence: 5}, nil
+	}, log.NewNopLogger())
+
+	// the concurrent senders sharing a fee payer get distinct and increasing sequences of the payer,
+	// whatever the order of the addresses of their signers
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var sequences []uint64
+	for i := 0; i < 20; i++ {
+		sender := "a"
+		if i%2 == 1 {
+			sender = "z"
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tickets, err := m.acquireAll(context.Background(), sender, "payer")
+			require.NoError(t, err)
+			require.Equal(t, sender, tickets[0].address)
+			require.Equal(t, "payer", tickets[1].address)
+			mu.Lock()
+			sequences = append(sequences, tickets[1].sequence)
+			mu.Unlock()
+			for _, ticket := range tickets {
+				m.release(ticket, true)
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, sequences, 20)
+	for i, seq := range sequences {
+		require.Equal(t, uint64(5+i), seq)
+	}
+	require.Equal(t, sdk.SequenceMetrics{Next: 25}, m.metrics("payer"))
+	require.Equal(t, sdk.SequenceMetrics{Next: 15}, m.metrics("a"))
+
+	// a mismatch of the payer resyncs the payer only
+	tickets, err := m.acquireAll(context.Background(), "a", "payer")
+	require.NoError(t, err)
+	m.resyncAll(tickets, "account sequence mismatch, expected 20, got 25: incorrect account sequence")
+	require.Equal(t, sdk.SequenceMetrics{Next: 15}, m.metrics("a"))
+	require.Equal(t, sdk.SequenceMetrics{Next: 20, Resyncs: 1, Gaps: 5}, m.metrics("payer"))
+
+	// a mismatch of the sender resyncs the sender only
+	tickets, err = m.acquireAll(context.Background(), "a", "payer")
+	require.NoError(t, err)
+	m.resyncAll(tickets, "account sequence mismatch, expected 14, got 15: incorrect account sequence")
+	require.Equal(t, sdk.SequenceMetrics{Next: 14, Resyncs: 1, Gaps: 1}, m.metrics("a"))
+	require.Equal(t, sdk.SequenceMetrics{Next: 20, Resyncs: 1, Gaps: 5}, m.metrics("payer"))
+
+	tickets, err = m.acquireAll(context.Background(), "a", "payer")
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), tickets[0].sequence)
+	require.Equal(t, uint64(20), tickets[1].sequence)
+	for _, ticket := range tickets {
+		m.release(ticket, false)
+	}
+}
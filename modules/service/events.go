@@ -0,0 +1,51 @@
+package service
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type CreateContextEvent struct {
+	RequestContextID string `event:"request_context_id,required"`
+	Consumer         string `event:"consumer"`
+}
+
+func (CreateContextEvent) EventType() string { return sdk.EventTypeCreateContext }
+
+type RespondServiceEvent struct {
+	RequestContextID string `event:"request_context_id"`
+	RequestID        string `event:"request_id"`
+	ServiceName      string `event:"service_name"`
+	Provider         string `event:"provider"`
+	Consumer         string `event:"consumer"`
+}
+
+func (RespondServiceEvent) EventType() string { return sdk.EventTypeResponseService }
+
+// NewBatchRequestEvent is emitted in the end block for every new batch of a request context,
+// Requests is the JSON array of the compact requests of the batch
+type NewBatchRequestEvent struct {
+	RequestContextID string `event:"request_context_id"`
+	Requests         string `event:"requests"`
+}
+
+func (NewBatchRequestEvent) EventType() string { return eventTypeNewBatchRequest }
+
+// NewBatchRequestProviderEvent is emitted in the end block for every provider of a new batch,
+// Requests is the JSON array of the request ids of the provider
+type NewBatchRequestProviderEvent struct {
+	ServiceName string `event:"service_name"`
+	Provider    string `event:"provider"`
+	Requests    string `event:"requests"`
+}
+
+func (NewBatchRequestProviderEvent) EventType() string { return eventTypeNewBatchRequestProvider }
+
+// RegisterEvents registers the typed events of the service module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		CreateContextEvent{},
+		RespondServiceEvent{},
+		NewBatchRequestEvent{},
+		NewBatchRequestProviderEvent{},
+	)
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+
 	"time"
 
 	sdk "github.com/irisnet/irishub-sdk-go/types"
@@ -37,6 +39,9 @@ type Query interface {
 	QueryRequestsByReqCtx(requestContextID string, batchCounter uint64, pageReq *query.PageRequest) ([]QueryServiceRequestResponse, sdk.Error)
 	QueryServiceResponse(requestID string) (QueryServiceResponseResponse, sdk.Error)
 	QueryServiceResponses(requestContextID string, batchCounter uint64, pageReq *query.PageRequest) ([]QueryServiceResponseResponse, sdk.Error)
+	IterateServiceBindings(serviceName string, opts sdk.PageOptions, callback func(QueryServiceBindingResponse) bool) sdk.Error
+	IterateServiceRequests(serviceName string, provider string, opts sdk.PageOptions, callback func(QueryServiceRequestResponse) bool) sdk.Error
+	IterateServiceResponses(requestContextID string, batchCounter uint64, opts sdk.PageOptions, callback func(QueryServiceResponseResponse) bool) sdk.Error
 	QueryRequestContext(requestContextID string) (QueryRequestContextResp, sdk.Error)
 	QueryFees(provider string) (sdk.Coins, sdk.Error)
 	QueryParams() (QueryParamsResp, sdk.Error)
@@ -45,6 +50,7 @@ type Query interface {
 // Client defines a set of interfaces in the service module
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 	Tx
 	Query
 }
@@ -1,7 +1,6 @@
 package service
 
 import (
-	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
@@ -62,7 +61,7 @@ func (s serviceClient) queryRequestByTxQuery(requestID string) (Request, error)
 		return Request{}, err
 	}
 
-	blockResult, err := s.BlockResults(context.Background(), &requestHeight)
+	blockResult, err := s.BlockResults(s.Context(), &requestHeight)
 	if err != nil {
 		return Request{}, err
 	}
@@ -32,6 +32,15 @@ func (s serviceClient) RegisterInterfaceTypes(registry types.InterfaceRegistry)
 	RegisterInterfaces(registry)
 }
 
+func (s serviceClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (s serviceClient) WithContext(ctx context.Context) Client {
+	return NewClient(s.BaseClient.WithContext(ctx), s.Marshaler)
+}
+
 //DefineService is responsible for creating a new service definition
 func (s serviceClient) DefineService(request DefineServiceRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	author, err := s.QueryAddress(baseTx.From, baseTx.Password)
@@ -204,10 +213,11 @@ func (s serviceClient) InvokeService(request InvokeServiceRequest, baseTx sdk.Ba
 		return "", sdk.ResultTx{}, sdk.Wrap(err)
 	}
 
-	reqCtxID, e := result.Events.GetValue(sdk.EventTypeCreateContext, attributeKeyRequestContextID)
-	if e != nil {
-		return reqCtxID, result, sdk.Wrap(e)
+	var event CreateContextEvent
+	if e := sdk.DecodeEvent(result.Events, &event); e != nil {
+		return "", result, sdk.Wrap(e)
 	}
+	reqCtxID := event.RequestContextID
 
 	if request.Callback == nil {
 		return reqCtxID, result, nil
@@ -439,13 +449,12 @@ func (s serviceClient) SubscribeServiceRequest(serviceName string,
 // QueryServiceDefinition return a service definition of the specified name
 func (s serviceClient) QueryServiceDefinition(serviceName string) (QueryServiceDefinitionResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryServiceDefinitionResponse{}, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).Definition(
-		context.Background(),
+		s.Context(),
 		&QueryDefinitionRequest{ServiceName: serviceName},
 	)
 	if err != nil {
@@ -458,7 +467,6 @@ func (s serviceClient) QueryServiceDefinition(serviceName string) (QueryServiceD
 // QueryServiceBinding return the specified service binding
 func (s serviceClient) QueryServiceBinding(serviceName string, provider string) (QueryServiceBindingResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryServiceBindingResponse{}, sdk.Wrap(err)
 	}
@@ -468,7 +476,7 @@ func (s serviceClient) QueryServiceBinding(serviceName string, provider string)
 	}
 
 	resp, err := NewQueryClient(conn).Binding(
-		context.Background(),
+		s.Context(),
 		&QueryBindingRequest{
 			ServiceName: serviceName,
 			Provider:    provider,
@@ -484,13 +492,12 @@ func (s serviceClient) QueryServiceBinding(serviceName string, provider string)
 // QueryServiceBindings returns all bindings of the specified service
 func (s serviceClient) QueryServiceBindings(serviceName string, pageReq *query.PageRequest) ([]QueryServiceBindingResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).Bindings(
-		context.Background(),
+		s.Context(),
 		&QueryBindingsRequest{
 			ServiceName: serviceName,
 			Pagination:  pageReq,
@@ -506,13 +513,12 @@ func (s serviceClient) QueryServiceBindings(serviceName string, pageReq *query.P
 // QueryServiceRequest returns  the active request of the specified requestID
 func (s serviceClient) QueryServiceRequest(requestID string) (QueryServiceRequestResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryServiceRequestResponse{}, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).Request(
-		context.Background(),
+		s.Context(),
 		&QueryRequestRequest{RequestId: requestID},
 	)
 
@@ -532,7 +538,6 @@ func (s serviceClient) QueryServiceRequest(requestID string) (QueryServiceReques
 // QueryServiceRequests returns all the active requests of the specified service binding
 func (s serviceClient) QueryServiceRequests(serviceName string, provider string, pageReq *query.PageRequest) ([]QueryServiceRequestResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
@@ -542,7 +547,7 @@ func (s serviceClient) QueryServiceRequests(serviceName string, provider string,
 	}
 
 	resp, err := NewQueryClient(conn).Requests(
-		context.Background(),
+		s.Context(),
 		&QueryRequestsRequest{
 			ServiceName: serviceName,
 			Provider:    provider,
@@ -559,13 +564,12 @@ func (s serviceClient) QueryServiceRequests(serviceName string, provider string,
 // QueryRequestsByReqCtx returns all requests of the specified request context ID and batch counter
 func (s serviceClient) QueryRequestsByReqCtx(reqCtxID string, batchCounter uint64, pageReq *query.PageRequest) ([]QueryServiceRequestResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).RequestsByReqCtx(
-		context.Background(),
+		s.Context(),
 		&QueryRequestsByReqCtxRequest{
 			RequestContextId: reqCtxID,
 			BatchCounter:     batchCounter,
@@ -582,13 +586,12 @@ func (s serviceClient) QueryRequestsByReqCtx(reqCtxID string, batchCounter uint6
 // QueryServiceResponse returns a response with the speicified request ID
 func (s serviceClient) QueryServiceResponse(requestID string) (QueryServiceResponseResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryServiceResponseResponse{}, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).Response(
-		context.Background(),
+		s.Context(),
 		&QueryResponseRequest{RequestId: requestID},
 	)
 
@@ -607,13 +610,12 @@ func (s serviceClient) QueryServiceResponse(requestID string) (QueryServiceRespo
 // QueryServiceResponses returns all responses of the specified request context and batch counter
 func (s serviceClient) QueryServiceResponses(reqCtxID string, batchCounter uint64, pageReq *query.PageRequest) ([]QueryServiceResponseResponse, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).Responses(
-		context.Background(),
+		s.Context(),
 		&QueryResponsesRequest{
 			RequestContextId: reqCtxID,
 			BatchCounter:     batchCounter,
@@ -627,16 +629,114 @@ func (s serviceClient) QueryServiceResponses(reqCtxID string, batchCounter uint6
 	return responses(resp.Responses).Convert().([]QueryServiceResponseResponse), nil
 }
 
+// IterateServiceBindings calls the callback with the bindings of the service page by page, until it
+// returns false or the bindings are exhausted
+func (s serviceClient) IterateServiceBindings(serviceName string, opts sdk.PageOptions, callback func(QueryServiceBindingResponse) bool) sdk.Error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := s.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := NewQueryClient(conn).Bindings(
+			s.Context(),
+			&QueryBindingsRequest{
+				ServiceName: serviceName,
+				Pagination:  &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(resp.ServiceBindings))
+		for i, binding := range resp.ServiceBindings {
+			items[i] = binding.Convert()
+		}
+		return items, resp.GetPagination().GetNextKey(), nil
+	}, opts)
+	return it.Each(func(item interface{}) bool {
+		return callback(item.(QueryServiceBindingResponse))
+	})
+}
+
+// IterateServiceRequests calls the callback with the active requests of the service binding page by
+// page, until it returns false or the requests are exhausted
+func (s serviceClient) IterateServiceRequests(serviceName string, provider string, opts sdk.PageOptions, callback func(QueryServiceRequestResponse) bool) sdk.Error {
+	if err := sdk.ValidateAccAddress(provider); err != nil {
+		return sdk.Wrap(err)
+	}
+
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := s.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := NewQueryClient(conn).Requests(
+			s.Context(),
+			&QueryRequestsRequest{
+				ServiceName: serviceName,
+				Provider:    provider,
+				Pagination:  &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(resp.Requests))
+		for i, request := range resp.Requests {
+			items[i] = request.Convert()
+		}
+		return items, resp.GetPagination().GetNextKey(), nil
+	}, opts)
+	return it.Each(func(item interface{}) bool {
+		return callback(item.(QueryServiceRequestResponse))
+	})
+}
+
+// IterateServiceResponses calls the callback with the responses of the request context and batch
+// counter page by page, until it returns false or the responses are exhausted
+func (s serviceClient) IterateServiceResponses(reqCtxID string, batchCounter uint64, opts sdk.PageOptions, callback func(QueryServiceResponseResponse) bool) sdk.Error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := s.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		resp, err := NewQueryClient(conn).Responses(
+			s.Context(),
+			&QueryResponsesRequest{
+				RequestContextId: reqCtxID,
+				BatchCounter:     batchCounter,
+				Pagination:       &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(resp.Responses))
+		for i, response := range resp.Responses {
+			items[i] = response.Convert()
+		}
+		return items, resp.GetPagination().GetNextKey(), nil
+	}, opts)
+	return it.Each(func(item interface{}) bool {
+		return callback(item.(QueryServiceResponseResponse))
+	})
+}
+
 // QueryRequestContext return the specified request context
 func (s serviceClient) QueryRequestContext(reqCtxID string) (QueryRequestContextResp, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryRequestContextResp{}, sdk.Wrap(err)
 	}
 
 	resp, err := NewQueryClient(conn).RequestContext(
-		context.Background(),
+		s.Context(),
 		&QueryRequestContextRequest{RequestContextId: reqCtxID},
 	)
 	if err == nil && !resp.RequestContext.Empty() {
@@ -658,13 +758,12 @@ func (s serviceClient) QueryFees(provider string) (sdk.Coins, sdk.Error) {
 	}
 
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return nil, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).EarnedFees(
-		context.Background(),
+		s.Context(),
 		&QueryEarnedFeesRequest{Provider: provider},
 	)
 	if err != nil {
@@ -675,13 +774,12 @@ func (s serviceClient) QueryFees(provider string) (sdk.Coins, sdk.Error) {
 
 func (s serviceClient) QueryParams() (QueryParamsResp, sdk.Error) {
 	conn, err := s.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Params(
-		context.Background(),
+		s.Context(),
 		&QueryParamsRequest{},
 	)
 	if err != nil {
@@ -695,21 +793,20 @@ func (s serviceClient) GenServiceResponseMsgs(events sdk.StringEvents, serviceNa
 	provider sdk.AccAddress,
 	handler RespondCallback) (msgs []sdk.Msg) {
 
+	var providerEvents []NewBatchRequestProviderEvent
+	if err := sdk.DecodeEvents(events, &providerEvents); err != nil {
+		s.Logger().Error("decode events failed", "errMsg", err.Error())
+		return
+	}
+
 	var ids []string
-	for _, e := range events {
-		if e.Type != eventTypeNewBatchRequestProvider {
-			continue
-		}
-		attributes := sdk.Attributes(e.Attributes)
-		svcName := attributes.GetValue(attributeKeyServiceName)
-		prov := attributes.GetValue(attributeKeyProvider)
-		if svcName == serviceName && prov == provider.String() {
-			reqIDsStr := attributes.GetValue(attributeKeyRequests)
+	for _, e := range providerEvents {
+		if e.ServiceName == serviceName && e.Provider == provider.String() {
 			var idsTemp []string
-			if err := json.Unmarshal([]byte(reqIDsStr), &idsTemp); err != nil {
+			if err := json.Unmarshal([]byte(e.Requests), &idsTemp); err != nil {
 				s.Logger().Error(
 					"service request don't exist",
-					attributeKeyRequestID, reqIDsStr,
+					attributeKeyRequestID, e.Requests,
 					attributeKeyServiceName, serviceName,
 					attributeKeyProvider, provider.String(),
 					"errMsg", err.Error(),
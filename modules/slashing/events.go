@@ -0,0 +1,32 @@
+package slashing
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// SlashEvent is emitted in the begin block when a validator is slashed for downtime or double signing
+type SlashEvent struct {
+	Address string `event:"address"`
+	Power   string `event:"power"`
+	Reason  string `event:"reason"`
+	Jailed  string `event:"jailed"`
+}
+
+func (SlashEvent) EventType() string { return "slash" }
+
+// LivenessEvent is emitted in the begin block when a validator missed a block
+type LivenessEvent struct {
+	Address      string `event:"address"`
+	MissedBlocks int64  `event:"missed_blocks"`
+	Height       int64  `event:"height"`
+}
+
+func (LivenessEvent) EventType() string { return "liveness" }
+
+// RegisterEvents registers the typed events of the slashing module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		SlashEvent{},
+		LivenessEvent{},
+	)
+}
@@ -0,0 +1,52 @@
+package slashing
+
+import (
+	"context"
+	"time"
+
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// expose Slashing module api for user
+type Client interface {
+	sdk.Module
+	WithContext(ctx context.Context) Client
+
+	Unjail(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
+
+	QuerySigningInfo(consAddr string) (QuerySigningInfoResp, sdk.Error)
+	QuerySigningInfos(page, size uint64) (QuerySigningInfosResp, sdk.Error)
+	IterateSigningInfos(opts sdk.PageOptions, callback func(QuerySigningInfoResp) bool) sdk.Error
+	QueryValidatorSigningInfo(validatorAddr string) (QueryValidatorSigningInfoResp, sdk.Error)
+	QueryParams() (QueryParamsResp, sdk.Error)
+}
+
+type QuerySigningInfoResp struct {
+	// consensus address of the validator
+	Address             string    `json:"address"`
+	StartHeight         int64     `json:"start_height"`
+	IndexOffset         int64     `json:"index_offset"`
+	JailedUntil         time.Time `json:"jailed_until"`
+	Tombstoned          bool      `json:"tombstoned"`
+	MissedBlocksCounter int64     `json:"missed_blocks_counter"`
+}
+
+type QuerySigningInfosResp struct {
+	SigningInfos []QuerySigningInfoResp `json:"signing_infos"`
+	Total        uint64                 `json:"total"`
+}
+
+// QueryValidatorSigningInfoResp holds a validator, with its jailed status, along with its signing info
+type QueryValidatorSigningInfoResp struct {
+	Validator   staking.QueryValidatorResp `json:"validator"`
+	SigningInfo QuerySigningInfoResp       `json:"signing_info"`
+}
+
+type QueryParamsResp struct {
+	SignedBlocksWindow      int64         `json:"signed_blocks_window"`
+	MinSignedPerWindow      sdk.Dec       `json:"min_signed_per_window"`
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration"`
+	SlashFractionDoubleSign sdk.Dec       `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime   sdk.Dec       `json:"slash_fraction_downtime"`
+}
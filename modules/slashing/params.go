@@ -0,0 +1,8 @@
+package slashing
+
+import yaml "gopkg.in/yaml.v2"
+
+func (i ValidatorSigningInfo) String() string {
+	out, _ := yaml.Marshal(i)
+	return string(out)
+}
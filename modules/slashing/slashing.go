@@ -0,0 +1,161 @@
+package slashing
+
+import (
+	"context"
+
+	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/types"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	"github.com/irisnet/irishub-sdk-go/types/query"
+	"github.com/irisnet/irishub-sdk-go/utils"
+)
+
+type slashingClient struct {
+	sdk.BaseClient
+	codec.Marshaler
+	queryValidator queryValidator
+}
+
+func NewClient(baseClient sdk.BaseClient, marshaler codec.Marshaler, queryValidator queryValidator) Client {
+	return &slashingClient{
+		BaseClient:     baseClient,
+		Marshaler:      marshaler,
+		queryValidator: queryValidator,
+	}
+}
+
+func (sc slashingClient) Name() string {
+	return ModuleName
+}
+
+func (sc slashingClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
+	RegisterInterfaces(registry)
+}
+
+func (sc slashingClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (sc slashingClient) WithContext(ctx context.Context) Client {
+	return NewClient(sc.BaseClient.WithContext(ctx), sc.Marshaler, sc.queryValidator)
+}
+
+// Unjail unjails the validator operated by the sender
+func (sc slashingClient) Unjail(baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
+	operator, err := sc.QueryAddress(baseTx.From, baseTx.Password)
+	if err != nil {
+		return sdk.ResultTx{}, sdk.Wrap(err)
+	}
+
+	msg := &MsgUnjail{
+		ValidatorAddr: sdk.ValAddress(operator).String(),
+	}
+	return sc.BuildAndSend([]sdk.Msg{msg}, baseTx)
+}
+
+// QuerySigningInfo returns the signing info of the validator with the consensus address
+func (sc slashingClient) QuerySigningInfo(consAddr string) (QuerySigningInfoResp, sdk.Error) {
+	conn, err := sc.GenConn()
+	if err != nil {
+		return QuerySigningInfoResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).SigningInfo(
+		sc.Context(),
+		&QuerySigningInfoRequest{
+			ConsAddress: consAddr,
+		},
+	)
+	if err != nil {
+		return QuerySigningInfoResp{}, sdk.Wrap(err)
+	}
+	return res.ValSigningInfo.Convert().(QuerySigningInfoResp), nil
+}
+
+func (sc slashingClient) QuerySigningInfos(page, size uint64) (QuerySigningInfosResp, sdk.Error) {
+	conn, err := sc.GenConn()
+	if err != nil {
+		return QuerySigningInfosResp{}, sdk.Wrap(err)
+	}
+
+	offset, limit := utils.ParsePage(page, size)
+	res, err := NewQueryClient(conn).SigningInfos(
+		sc.Context(),
+		&QuerySigningInfosRequest{
+			Pagination: &query.PageRequest{
+				Offset:     offset,
+				Limit:      limit,
+				CountTotal: true,
+			},
+		},
+	)
+	if err != nil {
+		return QuerySigningInfosResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QuerySigningInfosResp), nil
+}
+
+// IterateSigningInfos calls the callback with the signing infos of the validators page by page,
+// until it returns false or the signing infos are exhausted
+func (sc slashingClient) IterateSigningInfos(opts sdk.PageOptions, callback func(QuerySigningInfoResp) bool) sdk.Error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := sc.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		res, err := NewQueryClient(conn).SigningInfos(
+			sc.Context(),
+			&QuerySigningInfosRequest{
+				Pagination: &query.PageRequest{Key: key, Limit: limit},
+			},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(res.Info))
+		for i, info := range res.Info {
+			items[i] = info.Convert()
+		}
+		return items, res.GetPagination().GetNextKey(), nil
+	}, opts)
+	return it.Each(func(item interface{}) bool {
+		return callback(item.(QuerySigningInfoResp))
+	})
+}
+
+// QueryValidatorSigningInfo returns the validator with the operator address, with its jailed status,
+// along with its signing info holding its missed blocks counter
+func (sc slashingClient) QueryValidatorSigningInfo(validatorAddr string) (QueryValidatorSigningInfoResp, sdk.Error) {
+	validator, err := sc.queryValidator(validatorAddr)
+	if err != nil {
+		return QueryValidatorSigningInfoResp{}, err
+	}
+
+	info, err := sc.QuerySigningInfo(validator.ConsensusAddress)
+	if err != nil {
+		return QueryValidatorSigningInfoResp{}, err
+	}
+	return QueryValidatorSigningInfoResp{
+		Validator:   validator,
+		SigningInfo: info,
+	}, nil
+}
+
+func (sc slashingClient) QueryParams() (QueryParamsResp, sdk.Error) {
+	conn, err := sc.GenConn()
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+
+	res, err := NewQueryClient(conn).Params(
+		sc.Context(),
+		&QueryParamsRequest{},
+	)
+	if err != nil {
+		return QueryParamsResp{}, sdk.Wrap(err)
+	}
+	return res.Convert().(QueryParamsResp), nil
+}
@@ -0,0 +1,72 @@
+package slashing
+
+import (
+	"github.com/irisnet/irishub-sdk-go/modules/staking"
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	ModuleName = "slashing"
+)
+
+var (
+	_ sdk.Msg = &MsgUnjail{}
+)
+
+type queryValidator = func(validatorAddr string) (staking.QueryValidatorResp, sdk.Error)
+
+func (msg MsgUnjail) Route() string { return ModuleName }
+
+func (msg MsgUnjail) Type() string { return "unjail" }
+
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	validator, err := sdk.ValAddressFromBech32(msg.ValidatorAddr)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sdk.AccAddress(validator)}
+}
+
+func (msg MsgUnjail) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgUnjail) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddr); err != nil {
+		return sdk.WrapWithMessage(err, "invalid validator address")
+	}
+	return nil
+}
+
+func (i ValidatorSigningInfo) Convert() interface{} {
+	return QuerySigningInfoResp{
+		Address:             i.Address,
+		StartHeight:         i.StartHeight,
+		IndexOffset:         i.IndexOffset,
+		JailedUntil:         i.JailedUntil,
+		Tombstoned:          i.Tombstoned,
+		MissedBlocksCounter: i.MissedBlocksCounter,
+	}
+}
+
+func (q QuerySigningInfosResponse) Convert() interface{} {
+	infos := make([]QuerySigningInfoResp, len(q.Info))
+	for i, info := range q.Info {
+		infos[i] = info.Convert().(QuerySigningInfoResp)
+	}
+	return QuerySigningInfosResp{
+		SigningInfos: infos,
+		Total:        q.Pagination.GetTotal(),
+	}
+}
+
+func (q QueryParamsResponse) Convert() interface{} {
+	return QueryParamsResp{
+		SignedBlocksWindow:      q.Params.SignedBlocksWindow,
+		MinSignedPerWindow:      q.Params.MinSignedPerWindow,
+		DowntimeJailDuration:    q.Params.DowntimeJailDuration,
+		SlashFractionDoubleSign: q.Params.SlashFractionDoubleSign,
+		SlashFractionDowntime:   q.Params.SlashFractionDowntime,
+	}
+}
@@ -0,0 +1,60 @@
+package staking
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+// NewStakeAuthorization returns an authorization of the grantee to delegate, undelegate or
+// redelegate at most maxTokens, unlimited if nil, on behalf of the granter, to the allowed
+// validators or to any validator but the denied ones
+func NewStakeAuthorization(allowed, denied []string, authzType AuthorizationType, maxTokens *sdk.Coin) (*StakeAuthorization, error) {
+	if len(allowed) > 0 && len(denied) > 0 {
+		return nil, errors.New("cannot set both allowed and denied validators")
+	}
+	if len(allowed) == 0 && len(denied) == 0 {
+		return nil, errors.New("allowed or denied validators must be set")
+	}
+
+	a := &StakeAuthorization{
+		MaxTokens:         maxTokens,
+		AuthorizationType: authzType,
+	}
+	if len(allowed) > 0 {
+		a.Validators = &StakeAuthorization_AllowList{AllowList: &StakeAuthorization_Validators{Address: allowed}}
+	} else {
+		a.Validators = &StakeAuthorization_DenyList{DenyList: &StakeAuthorization_Validators{Address: denied}}
+	}
+	return a, a.ValidateBasic()
+}
+
+// MsgTypeURL returns the type url of the msg granted by the authorization type
+func (a StakeAuthorization) MsgTypeURL() string {
+	switch a.AuthorizationType {
+	case AuthorizationType_AUTHORIZATION_TYPE_DELEGATE:
+		return sdk.MsgTypeURL(&MsgDelegate{})
+	case AuthorizationType_AUTHORIZATION_TYPE_UNDELEGATE:
+		return sdk.MsgTypeURL(&MsgUndelegate{})
+	case AuthorizationType_AUTHORIZATION_TYPE_REDELEGATE:
+		return sdk.MsgTypeURL(&MsgBeginRedelegate{})
+	default:
+		return ""
+	}
+}
+
+func (a StakeAuthorization) ValidateBasic() error {
+	if a.MaxTokens != nil && a.MaxTokens.IsNegative() {
+		return fmt.Errorf("negative coin amount: %s", a.MaxTokens)
+	}
+	if a.AuthorizationType == AuthorizationType_AUTHORIZATION_TYPE_UNSPECIFIED {
+		return errors.New("unknown authorization type")
+	}
+	for _, validator := range append(a.GetAllowList().GetAddress(), a.GetDenyList().GetAddress()...) {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return sdk.WrapWithMessage(err, "invalid validator address")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package staking
+
+import (
+	"time"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type CreateValidatorEvent struct {
+	Validator string `event:"validator"`
+	Amount    string `event:"amount"`
+}
+
+func (CreateValidatorEvent) EventType() string { return "create_validator" }
+
+type EditValidatorEvent struct {
+	CommissionRate    string `event:"commission_rate"`
+	MinSelfDelegation string `event:"min_self_delegation"`
+}
+
+func (EditValidatorEvent) EventType() string { return "edit_validator" }
+
+type DelegateEvent struct {
+	Validator string `event:"validator"`
+	Amount    string `event:"amount"`
+	NewShares string `event:"new_shares"`
+}
+
+func (DelegateEvent) EventType() string { return "delegate" }
+
+type UnbondEvent struct {
+	Validator      string    `event:"validator"`
+	Amount         string    `event:"amount"`
+	CompletionTime time.Time `event:"completion_time"`
+}
+
+func (UnbondEvent) EventType() string { return "unbond" }
+
+type RedelegateEvent struct {
+	SourceValidator      string    `event:"source_validator"`
+	DestinationValidator string    `event:"destination_validator"`
+	Amount               string    `event:"amount"`
+	CompletionTime       time.Time `event:"completion_time"`
+}
+
+func (RedelegateEvent) EventType() string { return "redelegate" }
+
+// CompleteUnbondingEvent is emitted in the end block once an unbonding delegation is mature
+type CompleteUnbondingEvent struct {
+	Validator string `event:"validator"`
+	Delegator string `event:"delegator"`
+	Amount    string `event:"amount"`
+}
+
+func (CompleteUnbondingEvent) EventType() string { return "complete_unbonding" }
+
+// CompleteRedelegationEvent is emitted in the end block once a redelegation is mature
+type CompleteRedelegationEvent struct {
+	Delegator            string `event:"delegator"`
+	SourceValidator      string `event:"source_validator"`
+	DestinationValidator string `event:"destination_validator"`
+	Amount               string `event:"amount"`
+}
+
+func (CompleteRedelegationEvent) EventType() string { return "complete_redelegation" }
+
+// RegisterEvents registers the typed events of the staking module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		CreateValidatorEvent{},
+		EditValidatorEvent{},
+		DelegateEvent{},
+		UnbondEvent{},
+		RedelegateEvent{},
+		CompleteUnbondingEvent{},
+		CompleteRedelegationEvent{},
+	)
+}
@@ -1,6 +1,8 @@
 package staking
 
 import (
+	"context"
+
 	"time"
 
 	sdk "github.com/irisnet/irishub-sdk-go/types"
@@ -9,6 +11,7 @@ import (
 // expose Staking module api for user
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	CreateValidator(request CreateValidatorRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	EditValidator(request EditValidatorRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
@@ -30,6 +33,12 @@ type Client interface {
 	QueryHistoricalInfo(height int64) (QueryHistoricalInfoResp, sdk.Error)
 	QueryPool() (QueryPoolResp, sdk.Error)
 	QueryParams() (QueryParamsResp, sdk.Error)
+	IterateValidators(status string, opts sdk.PageOptions, callback func(QueryValidatorResp) bool) sdk.Error
+	IterateValidatorDelegations(validatorAddr string, opts sdk.PageOptions, callback func(QueryDelegationResp) bool) sdk.Error
+	IterateDelegatorDelegations(delegatorAddr string, opts sdk.PageOptions, callback func(QueryDelegationResp) bool) sdk.Error
+	IterateDelegatorUnbondingDelegations(delegatorAddr string, opts sdk.PageOptions, callback func(QueryUnbondingDelegationResp) bool) sdk.Error
+	SubscribeDelegateTx(delegator, validator string, callback EventMsgDelegateCallback) (sdk.Subscription, sdk.Error)
+	SubscribeUndelegateTx(delegator, validator string, callback EventMsgUndelegateCallback) (sdk.Subscription, sdk.Error)
 }
 
 type CreateValidatorRequest struct {
@@ -94,6 +103,7 @@ type (
 	QueryValidatorResp struct {
 		OperatorAddress   string      `json:"operator_address"`
 		ConsensusPubkey   string      `json:"consensus_pubkey"`
+		ConsensusAddress  string      `json:"consensus_address"`
 		Jailed            bool        `json:"jailed"`
 		Status            string      `json:"status"`
 		Tokens            sdk.Int     `json:"tokens"`
@@ -213,3 +223,7 @@ type QueryParamsResp struct {
 	HistoricalEntries uint32        `json:"historical_entries"`
 	BondDenom         string        `json:"bond_denom"`
 }
+
+type EventMsgDelegateCallback func(msg *MsgDelegate, data sdk.EventDataMsg)
+
+type EventMsgUndelegateCallback func(msg *MsgUndelegate, data sdk.EventDataMsg)
@@ -30,6 +30,15 @@ func (sc stakingClient) RegisterInterfaceTypes(registry types.InterfaceRegistry)
 	RegisterInterfaces(registry)
 }
 
+func (sc stakingClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (sc stakingClient) WithContext(ctx context.Context) Client {
+	return NewClient(sc.BaseClient.WithContext(ctx), sc.Marshaler)
+}
+
 func (sc stakingClient) CreateValidator(request CreateValidatorRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	delegatorAddr, err := sc.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
@@ -157,14 +166,13 @@ func (sc stakingClient) BeginRedelegate(request BeginRedelegateRequest, baseTx s
 // about status, you can see BondStatus_value
 func (sc stakingClient) QueryValidators(status string, page, size uint64) (QueryValidatorsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryValidatorsResp{}, sdk.Wrap(err)
 	}
 
 	offset, limit := utils.ParsePage(page, size)
 	res, err := NewQueryClient(conn).Validators(
-		context.Background(),
+		sc.Context(),
 		&QueryValidatorsRequest{
 			Status: status,
 			Pagination: &query.PageRequest{
@@ -182,13 +190,12 @@ func (sc stakingClient) QueryValidators(status string, page, size uint64) (Query
 
 func (sc stakingClient) QueryValidator(validatorAddr string) (QueryValidatorResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryValidatorResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Validator(
-		context.Background(),
+		sc.Context(),
 		&QueryValidatorRequest{
 			ValidatorAddr: validatorAddr,
 		},
@@ -201,14 +208,13 @@ func (sc stakingClient) QueryValidator(validatorAddr string) (QueryValidatorResp
 
 func (sc stakingClient) QueryValidatorDelegations(validatorAddr string, page, size uint64) (QueryValidatorDelegationsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryValidatorDelegationsResp{}, sdk.Wrap(err)
 	}
 
 	offset, limit := utils.ParsePage(page, size)
 	res, err := NewQueryClient(conn).ValidatorDelegations(
-		context.Background(),
+		sc.Context(),
 		&QueryValidatorDelegationsRequest{
 			ValidatorAddr: validatorAddr,
 			Pagination: &query.PageRequest{
@@ -226,14 +232,13 @@ func (sc stakingClient) QueryValidatorDelegations(validatorAddr string, page, si
 
 func (sc stakingClient) QueryValidatorUnbondingDelegations(validatorAddr string, page, size uint64) (QueryValidatorUnbondingDelegationsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryValidatorUnbondingDelegationsResp{}, sdk.Wrap(err)
 	}
 
 	offset, limit := utils.ParsePage(page, size)
 	res, err := NewQueryClient(conn).ValidatorUnbondingDelegations(
-		context.Background(),
+		sc.Context(),
 		&QueryValidatorUnbondingDelegationsRequest{
 			ValidatorAddr: validatorAddr,
 			Pagination: &query.PageRequest{
@@ -251,13 +256,12 @@ func (sc stakingClient) QueryValidatorUnbondingDelegations(validatorAddr string,
 
 func (sc stakingClient) QueryDelegation(delegatorAddr string, validatorAddr string) (QueryDelegationResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryDelegationResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Delegation(
-		context.Background(),
+		sc.Context(),
 		&QueryDelegationRequest{
 			DelegatorAddr: delegatorAddr,
 			ValidatorAddr: validatorAddr,
@@ -271,13 +275,12 @@ func (sc stakingClient) QueryDelegation(delegatorAddr string, validatorAddr stri
 
 func (sc stakingClient) QueryUnbondingDelegation(delegatorAddr string, validatorAddr string) (QueryUnbondingDelegationResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryUnbondingDelegationResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).UnbondingDelegation(
-		context.Background(),
+		sc.Context(),
 		&QueryUnbondingDelegationRequest{
 			DelegatorAddr: delegatorAddr,
 			ValidatorAddr: validatorAddr,
@@ -291,14 +294,13 @@ func (sc stakingClient) QueryUnbondingDelegation(delegatorAddr string, validator
 
 func (sc stakingClient) QueryDelegatorDelegations(delegatorAddr string, page, size uint64) (QueryDelegatorDelegationsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryDelegatorDelegationsResp{}, sdk.Wrap(err)
 	}
 
 	offset, limit := utils.ParsePage(page, size)
 	res, err := NewQueryClient(conn).DelegatorDelegations(
-		context.Background(),
+		sc.Context(),
 		&QueryDelegatorDelegationsRequest{
 			DelegatorAddr: delegatorAddr,
 			Pagination: &query.PageRequest{
@@ -316,14 +318,13 @@ func (sc stakingClient) QueryDelegatorDelegations(delegatorAddr string, page, si
 
 func (sc stakingClient) QueryDelegatorUnbondingDelegations(delegatorAddr string, page, size uint64) (QueryDelegatorUnbondingDelegationsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryDelegatorUnbondingDelegationsResp{}, sdk.Wrap(err)
 	}
 
 	offset, limit := utils.ParsePage(page, size)
 	res, err := NewQueryClient(conn).DelegatorUnbondingDelegations(
-		context.Background(),
+		sc.Context(),
 		&QueryDelegatorUnbondingDelegationsRequest{
 			DelegatorAddr: delegatorAddr,
 			Pagination: &query.PageRequest{
@@ -341,14 +342,13 @@ func (sc stakingClient) QueryDelegatorUnbondingDelegations(delegatorAddr string,
 
 func (sc stakingClient) QueryRedelegations(request QueryRedelegationsReq) (QueryRedelegationsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryRedelegationsResp{}, sdk.Wrap(err)
 	}
 
 	offset, limit := utils.ParsePage(request.Page, request.Size)
 	res, err := NewQueryClient(conn).Redelegations(
-		context.Background(),
+		sc.Context(),
 		&QueryRedelegationsRequest{
 			DelegatorAddr:    request.DelegatorAddr,
 			SrcValidatorAddr: request.SrcValidatorAddr,
@@ -368,14 +368,13 @@ func (sc stakingClient) QueryRedelegations(request QueryRedelegationsReq) (Query
 
 func (sc stakingClient) QueryDelegatorValidators(delegatorAddr string, page, size uint64) (QueryDelegatorValidatorsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryDelegatorValidatorsResp{}, sdk.Wrap(err)
 	}
 
 	offset, limit := utils.ParsePage(page, size)
 	res, err := NewQueryClient(conn).DelegatorValidators(
-		context.Background(),
+		sc.Context(),
 		&QueryDelegatorValidatorsRequest{
 			DelegatorAddr: delegatorAddr,
 			Pagination: &query.PageRequest{
@@ -393,13 +392,12 @@ func (sc stakingClient) QueryDelegatorValidators(delegatorAddr string, page, siz
 
 func (sc stakingClient) QueryDelegatorValidator(delegatorAddr string, validatorAddr string) (QueryValidatorResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryValidatorResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).DelegatorValidator(
-		context.Background(),
+		sc.Context(),
 		&QueryDelegatorValidatorRequest{
 			DelegatorAddr: delegatorAddr,
 			ValidatorAddr: validatorAddr,
@@ -414,13 +412,12 @@ func (sc stakingClient) QueryDelegatorValidator(delegatorAddr string, validatorA
 // QueryHistoricalInfo tendermint only save latest 100 block, previous block is aborted
 func (sc stakingClient) QueryHistoricalInfo(height int64) (QueryHistoricalInfoResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryHistoricalInfoResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).HistoricalInfo(
-		context.Background(),
+		sc.Context(),
 		&QueryHistoricalInfoRequest{
 			Height: height,
 		},
@@ -433,13 +430,12 @@ func (sc stakingClient) QueryHistoricalInfo(height int64) (QueryHistoricalInfoRe
 
 func (sc stakingClient) QueryPool() (QueryPoolResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryPoolResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Pool(
-		context.Background(),
+		sc.Context(),
 		&QueryPoolRequest{},
 	)
 	if err != nil {
@@ -454,13 +450,12 @@ func (sc stakingClient) QueryPool() (QueryPoolResp, sdk.Error) {
 
 func (sc stakingClient) QueryParams() (QueryParamsResp, sdk.Error) {
 	conn, err := sc.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Params(
-		context.Background(),
+		sc.Context(),
 		&QueryParamsRequest{},
 	)
 	if err != nil {
@@ -468,3 +463,138 @@ func (sc stakingClient) QueryParams() (QueryParamsResp, sdk.Error) {
 	}
 	return res.Convert().(QueryParamsResp), nil
 }
+
+// SubscribeDelegateTx subscribes the delegations of the delegator to the validator, an empty address matches any
+func (sc stakingClient) SubscribeDelegateTx(delegator, validator string, callback EventMsgDelegateCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgDelegate{}.Type())
+	if len(delegator) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(delegator)))
+	}
+	return sdk.SubscribeMsgs(sc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgDelegate)
+		if !ok || (len(delegator) > 0 && msg.DelegatorAddress != delegator) ||
+			(len(validator) > 0 && msg.ValidatorAddress != validator) {
+			return
+		}
+		callback(msg, data)
+	})
+}
+
+// SubscribeUndelegateTx subscribes the undelegations of the delegator from the validator, an empty address matches any
+func (sc stakingClient) SubscribeUndelegateTx(delegator, validator string, callback EventMsgUndelegateCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgUndelegate{}.Type())
+	if len(delegator) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(delegator)))
+	}
+	return sdk.SubscribeMsgs(sc.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgUndelegate)
+		if !ok || (len(delegator) > 0 && msg.DelegatorAddress != delegator) ||
+			(len(validator) > 0 && msg.ValidatorAddress != validator) {
+			return
+		}
+		callback(msg, data)
+	})
+}
+
+// IterateValidators calls the callback with the validators of the status (all of them when status
+// is "") page by page, until it returns false or the validators are exhausted
+func (sc stakingClient) IterateValidators(status string, opts sdk.PageOptions, callback func(QueryValidatorResp) bool) sdk.Error {
+	return sc.iterate(opts, func(client QueryClient, page *query.PageRequest) ([]interface{}, *query.PageResponse, error) {
+		res, err := client.Validators(sc.Context(), &QueryValidatorsRequest{Status: status, Pagination: page})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(res.Validators))
+		for i, v := range res.Validators {
+			items[i] = v.Convert(sc.Marshaler)
+		}
+		return items, res.Pagination, nil
+	}, func(item interface{}) bool {
+		return callback(item.(QueryValidatorResp))
+	})
+}
+
+// IterateValidatorDelegations calls the callback with the delegations to the validator page by page,
+// until it returns false or the delegations are exhausted
+func (sc stakingClient) IterateValidatorDelegations(validatorAddr string, opts sdk.PageOptions, callback func(QueryDelegationResp) bool) sdk.Error {
+	return sc.iterate(opts, func(client QueryClient, page *query.PageRequest) ([]interface{}, *query.PageResponse, error) {
+		res, err := client.ValidatorDelegations(sc.Context(), &QueryValidatorDelegationsRequest{
+			ValidatorAddr: validatorAddr,
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return convertDelegations(res.DelegationResponses), res.Pagination, nil
+	}, func(item interface{}) bool {
+		return callback(item.(QueryDelegationResp))
+	})
+}
+
+// IterateDelegatorDelegations calls the callback with the delegations of the delegator page by page,
+// until it returns false or the delegations are exhausted
+func (sc stakingClient) IterateDelegatorDelegations(delegatorAddr string, opts sdk.PageOptions, callback func(QueryDelegationResp) bool) sdk.Error {
+	return sc.iterate(opts, func(client QueryClient, page *query.PageRequest) ([]interface{}, *query.PageResponse, error) {
+		res, err := client.DelegatorDelegations(sc.Context(), &QueryDelegatorDelegationsRequest{
+			DelegatorAddr: delegatorAddr,
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		return convertDelegations(res.DelegationResponses), res.Pagination, nil
+	}, func(item interface{}) bool {
+		return callback(item.(QueryDelegationResp))
+	})
+}
+
+// IterateDelegatorUnbondingDelegations calls the callback with the unbonding delegations of the
+// delegator page by page, until it returns false or the unbonding delegations are exhausted
+func (sc stakingClient) IterateDelegatorUnbondingDelegations(delegatorAddr string, opts sdk.PageOptions, callback func(QueryUnbondingDelegationResp) bool) sdk.Error {
+	return sc.iterate(opts, func(client QueryClient, page *query.PageRequest) ([]interface{}, *query.PageResponse, error) {
+		res, err := client.DelegatorUnbondingDelegations(sc.Context(), &QueryDelegatorUnbondingDelegationsRequest{
+			DelegatorAddr: delegatorAddr,
+			Pagination:    page,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(res.UnbondingResponses))
+		for i, u := range res.UnbondingResponses {
+			items[i] = u.Convert()
+		}
+		return items, res.Pagination, nil
+	}, func(item interface{}) bool {
+		return callback(item.(QueryUnbondingDelegationResp))
+	})
+}
+
+// iterate calls the callback with the items of a paginated query page by page, fetch returns
+// the items of the requested page and the pagination of the response
+func (sc stakingClient) iterate(opts sdk.PageOptions,
+	fetch func(client QueryClient, page *query.PageRequest) ([]interface{}, *query.PageResponse, error),
+	callback func(item interface{}) bool) sdk.Error {
+	it := sdk.NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		conn, err := sc.GenConn()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items, page, err := fetch(NewQueryClient(conn), &query.PageRequest{Key: key, Limit: limit})
+		if err != nil {
+			return nil, nil, err
+		}
+		return items, page.GetNextKey(), nil
+	}, opts)
+	return it.Each(callback)
+}
+
+func convertDelegations(delegations DelegationResponses) []interface{} {
+	items := make([]interface{}, len(delegations))
+	for i, d := range delegations {
+		items[i] = d.Convert()
+	}
+	return items
+}
@@ -280,12 +280,13 @@ func (q QueryValidatorsResponse) Convert(cdc codec.Marshaler) interface{} {
 func (v Validator) Convert(cdc codec.Marshaler) interface{} {
 	pubKey, _ := v.GetPubKey(cdc)
 	return QueryValidatorResp{
-		OperatorAddress: v.OperatorAddress,
-		ConsensusPubkey: pubKey.String(),
-		Jailed:          v.Jailed,
-		Status:          BondStatus_name[int32(v.Status)],
-		Tokens:          v.Tokens,
-		DelegatorShares: v.DelegatorShares,
+		OperatorAddress:  v.OperatorAddress,
+		ConsensusPubkey:  pubKey.String(),
+		ConsensusAddress: sdk.ConsAddress(pubKey.Address()).String(),
+		Jailed:           v.Jailed,
+		Status:           BondStatus_name[int32(v.Status)],
+		Tokens:           v.Tokens,
+		DelegatorShares:  v.DelegatorShares,
 		Description: description{
 			Moniker:         v.Description.Moniker,
 			Identity:        v.Description.Identity,
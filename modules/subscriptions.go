@@ -0,0 +1,462 @@
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+const (
+	minReconnectBackoff = 1 * time.Second
+	maxReconnectBackoff = 30 * time.Second
+	wsRequestTimeout    = 10 * time.Second
+	backfillPageSize    = 100
+	// the blocks are fetched one by one, a longer gap is not backfilled
+	maxBackfillBlocks = 1000
+)
+
+var eventTypeRegexp = regexp.MustCompile(`^tm\.event\s*=\s*'(\w+)'$`)
+
+// subscriptions keeps the queries of the websocket subscriptions registered on a node. When
+// the connection drops, it is dialed again with backoff, on the same node or on another
+// healthy node of the pool, every query is registered again and the blocks and txs missed
+// in the meantime are optionally backfilled from the node before the live events resume.
+type subscriptions struct {
+	pool     *nodePool
+	logger   log.Logger
+	backfill bool
+	notify   sdk.SubscriptionEventHandler
+
+	mu           sync.Mutex
+	ws           *jsonrpcclient.WSClient
+	node         *node
+	connected    bool
+	reconnecting bool
+	subs         map[string]*subscription
+}
+
+type subscription struct {
+	id      string
+	query   string
+	handler func(data tmtypes.TMEventData)
+	// called when the subscription is removed
+	stop func()
+
+	// guarded by subscriptions.mu
+	lastHeight int64
+	// the live events are held back while the missed ones are backfilled
+	resuming bool
+	pending  []tmtypes.TMEventData
+}
+
+func newSubscriptions(pool *nodePool, backfill bool, notify sdk.SubscriptionEventHandler, logger log.Logger) *subscriptions {
+	if notify == nil {
+		notify = func(sdk.SubscriptionEvent) {}
+	}
+	return &subscriptions{
+		pool:     pool,
+		logger:   logger,
+		backfill: backfill,
+		notify:   notify,
+		subs:     make(map[string]*subscription),
+	}
+}
+
+// subscribe registers the query, the connection is dialed by the first subscription. A
+// subscription made while reconnecting is registered once the connection is back.
+func (s *subscriptions) subscribe(ctx context.Context, id, query string, handler func(data tmtypes.TMEventData), stop func()) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ws == nil && !s.reconnecting {
+		ws, n, err := s.dial()
+		if err != nil {
+			return err
+		}
+		s.ws, s.node, s.connected = ws, n, true
+		go s.read(ws, n)
+	}
+
+	if s.ws != nil && s.connected && !s.subscribed(query) {
+		if err := s.ws.Subscribe(ctx, query); err != nil {
+			return err
+		}
+	}
+	s.subs[id] = &subscription{id: id, query: query, handler: handler, stop: stop}
+	return nil
+}
+
+// unsubscribe removes the subscription, the query is unregistered when no other subscription uses it
+func (s *subscriptions) unsubscribe(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub, ok := s.subs[id]
+	if !ok {
+		return fmt.Errorf("subscription %s not found", id)
+	}
+	delete(s.subs, id)
+	if sub.stop != nil {
+		sub.stop()
+	}
+
+	if s.ws == nil || !s.connected || s.subscribed(sub.query) {
+		return nil
+	}
+	return s.ws.Unsubscribe(ctx, sub.query)
+}
+
+// subscribed reports whether a subscription uses the query, s.mu must be held
+func (s *subscriptions) subscribed(query string) bool {
+	for _, sub := range s.subs {
+		if sub.query == query {
+			return true
+		}
+	}
+	return false
+}
+
+// dial connects to the first reachable node in the order of the broadcasts
+func (s *subscriptions) dial() (*jsonrpcclient.WSClient, *node, error) {
+	err := errNoNode
+	for _, n := range s.pool.preferred() {
+		var ws *jsonrpcclient.WSClient
+		if ws, err = s.dialNode(n); err == nil {
+			return ws, n, nil
+		}
+		s.logger.Error("dial websocket failed", "node", n.remote, "errMsg", err.Error())
+	}
+	return nil, nil, err
+}
+
+// dialNode connects to the node. The client redials once by itself when the connection drops,
+// the subscriptions are resumed if it succeeds, otherwise the client stops and the
+// connection is taken over by reconnect.
+func (s *subscriptions) dialNode(n *node) (*jsonrpcclient.WSClient, error) {
+	var ws *jsonrpcclient.WSClient
+	ws, err := jsonrpcclient.NewWS(n.remote, "/websocket",
+		jsonrpcclient.MaxReconnectAttempts(0),
+		jsonrpcclient.OnReconnect(func() { s.resume(ws, n) }),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ws.SetLogger(s.logger)
+
+	// every dial after the first one is a redial of a dropped connection, the
+	// redials run on the reconnect routine of the client
+	dial, dialed := ws.Dialer, int32(0)
+	ws.Dialer = func(network, addr string) (net.Conn, error) {
+		if atomic.SwapInt32(&dialed, 1) == 1 {
+			s.disconnected(ws, nil)
+		}
+		return dial(network, addr)
+	}
+
+	if err := ws.Start(); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// read delivers the events received on the connection until the client stops
+func (s *subscriptions) read(ws *jsonrpcclient.WSClient, n *node) {
+	for resp := range ws.ResponsesCh {
+		if resp.Error != nil {
+			s.logger.Error("websocket error", "node", n.remote, "errMsg", resp.Error.Error())
+			continue
+		}
+
+		var result ctypes.ResultEvent
+		if err := tmjson.Unmarshal(resp.Result, &result); err != nil || len(result.Query) == 0 {
+			// the responses of subscribe and unsubscribe carry no event
+			continue
+		}
+		s.deliver(result.Query, result.Data)
+	}
+
+	s.mu.Lock()
+	current := s.ws == ws
+	if current {
+		s.ws = nil
+		s.reconnecting = true
+	}
+	s.mu.Unlock()
+
+	if current {
+		s.disconnected(ws, errors.New("websocket client stopped"))
+		go s.reconnect()
+	}
+}
+
+// reconnect dials the nodes with an exponential backoff until one of them is reachable
+func (s *subscriptions) reconnect() {
+	backoff := minReconnectBackoff
+	for {
+		time.Sleep(backoff)
+
+		ws, n, err := s.dial()
+		if err == nil {
+			s.mu.Lock()
+			s.ws, s.node, s.reconnecting = ws, n, false
+			s.mu.Unlock()
+
+			go s.read(ws, n)
+			s.resume(ws, n)
+			return
+		}
+
+		s.logger.Info("reconnect websocket failed", "backoff", backoff.String(), "errMsg", err.Error())
+		if backoff *= 2; backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+	}
+}
+
+// disconnected records that the connection dropped, it is reported once per disconnection
+func (s *subscriptions) disconnected(ws *jsonrpcclient.WSClient, err error) {
+	s.mu.Lock()
+	report := s.connected && (s.ws == nil || s.ws == ws)
+	remote := ""
+	if report {
+		s.connected = false
+		remote = s.node.remote
+	}
+	s.mu.Unlock()
+
+	if report {
+		s.logger.Info("websocket disconnected", "node", remote)
+		s.notify(sdk.SubscriptionEvent{Type: sdk.SubscriptionDisconnected, Node: remote, Error: err})
+	}
+}
+
+// resume registers the queries on the new connection and backfills the missed events
+func (s *subscriptions) resume(ws *jsonrpcclient.WSClient, n *node) {
+	s.mu.Lock()
+	s.connected = true
+	queries := make(map[string]bool)
+	var subs []*subscription
+	for _, sub := range s.subs {
+		queries[sub.query] = true
+		sub.resuming = s.backfill && sub.lastHeight > 0
+		if sub.resuming {
+			subs = append(subs, sub)
+		}
+	}
+	s.mu.Unlock()
+
+	var height int64
+	ctx, cancel := context.WithTimeout(context.Background(), wsRequestTimeout)
+	defer cancel()
+	status, err := n.client.Status(ctx)
+	if err == nil {
+		height = status.SyncInfo.LatestBlockHeight
+	}
+	s.logger.Info("websocket reconnected", "node", n.remote, "height", height)
+	s.notify(sdk.SubscriptionEvent{Type: sdk.SubscriptionReconnected, Node: n.remote, Height: height, Error: err})
+
+	for query := range queries {
+		err := ws.Subscribe(ctx, query)
+		s.notify(sdk.SubscriptionEvent{Type: sdk.SubscriptionResubscribed, Node: n.remote, Query: query, Error: err})
+	}
+
+	// the live events are received from now on, the backfill stops at the latest
+	// height after the resubscription so that nothing is missed in between
+	if len(subs) > 0 {
+		if status, err = n.client.Status(ctx); err == nil {
+			height = status.SyncInfo.LatestBlockHeight
+		}
+	}
+	for _, sub := range subs {
+		s.catchUp(n, sub, height)
+	}
+}
+
+// catchUp delivers the events of the subscription from its last height to the height, then
+// the live events held back in the meantime
+func (s *subscriptions) catchUp(n *node, sub *subscription, height int64) {
+	s.mu.Lock()
+	from := sub.lastHeight + 1
+	s.mu.Unlock()
+
+	var err error
+	if height >= from {
+		err = s.backfillEvents(n, sub, from, height)
+		s.notify(sdk.SubscriptionEvent{
+			Type:       sdk.SubscriptionBackfilled,
+			Node:       n.remote,
+			Query:      sub.query,
+			Height:     height,
+			FromHeight: from,
+			Error:      err,
+		})
+	}
+
+	s.mu.Lock()
+	pending := sub.pending
+	sub.pending, sub.resuming = nil, false
+	s.mu.Unlock()
+
+	for _, data := range pending {
+		// the events up to the height have been backfilled
+		if h := eventHeight(data); err == nil && h > 0 && h <= height {
+			continue
+		}
+		s.handle(sub, data)
+	}
+}
+
+// backfillEvents queries the blocks or the txs matching the query of the subscription,
+// each request has its own timeout as a backfill can span many of them
+func (s *subscriptions) backfillEvents(n *node, sub *subscription, from, to int64) error {
+	eventType, conditions := splitEventQuery(sub.query)
+	switch eventType {
+	case tmtypes.EventTx:
+		conditions = append(conditions, fmt.Sprintf("tx.height>=%d", from), fmt.Sprintf("tx.height<=%d", to))
+		query := strings.Join(conditions, " AND ")
+		for page := 1; ; page++ {
+			page, perPage := page, backfillPageSize
+			var res *ctypes.ResultTxSearch
+			err := withRequestTimeout(func(ctx context.Context) (err error) {
+				res, err = n.client.TxSearch(ctx, query, false, &page, &perPage, "asc")
+				return
+			})
+			if err != nil {
+				return err
+			}
+			for _, tx := range res.Txs {
+				s.handle(sub, tmtypes.EventDataTx{TxResult: abci.TxResult{
+					Height: tx.Height,
+					Index:  tx.Index,
+					Tx:     tx.Tx,
+					Result: tx.TxResult,
+				}})
+			}
+			if page*perPage >= res.TotalCount {
+				return nil
+			}
+		}
+	case tmtypes.EventNewBlock, tmtypes.EventNewBlockHeader:
+		if len(conditions) > 0 {
+			return fmt.Errorf("blocks of the query %s can not be backfilled", sub.query)
+		}
+		if to-from >= maxBackfillBlocks {
+			return fmt.Errorf("%d blocks missed, at most %d blocks are backfilled", to-from+1, maxBackfillBlocks)
+		}
+		for height := from; height <= to; height++ {
+			h := height
+			var block *ctypes.ResultBlock
+			var results *ctypes.ResultBlockResults
+			err := withRequestTimeout(func(ctx context.Context) (err error) {
+				if block, err = n.client.Block(ctx, &h); err != nil {
+					return
+				}
+				results, err = n.client.BlockResults(ctx, &h)
+				return
+			})
+			if err != nil {
+				return err
+			}
+
+			begin := abci.ResponseBeginBlock{Events: results.BeginBlockEvents}
+			end := abci.ResponseEndBlock{
+				ValidatorUpdates:      results.ValidatorUpdates,
+				ConsensusParamUpdates: results.ConsensusParamUpdates,
+				Events:                results.EndBlockEvents,
+			}
+			if eventType == tmtypes.EventNewBlock {
+				s.handle(sub, tmtypes.EventDataNewBlock{Block: block.Block, ResultBeginBlock: begin, ResultEndBlock: end})
+			} else {
+				s.handle(sub, tmtypes.EventDataNewBlockHeader{
+					Header:           block.Block.Header,
+					NumTxs:           int64(len(block.Block.Txs)),
+					ResultBeginBlock: begin,
+					ResultEndBlock:   end,
+				})
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("events of the query %s can not be backfilled", sub.query)
+	}
+}
+
+// withRequestTimeout calls fn with a context which times out after wsRequestTimeout
+func withRequestTimeout(fn func(ctx context.Context) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), wsRequestTimeout)
+	defer cancel()
+	return fn(ctx)
+}
+
+// deliver passes a live event to the subscriptions of the query
+func (s *subscriptions) deliver(query string, data tmtypes.TMEventData) {
+	s.mu.Lock()
+	var subs []*subscription
+	for _, sub := range s.subs {
+		if sub.query != query {
+			continue
+		}
+		if sub.resuming {
+			sub.pending = append(sub.pending, data)
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	s.mu.Unlock()
+
+	for _, sub := range subs {
+		s.handle(sub, data)
+	}
+}
+
+func (s *subscriptions) handle(sub *subscription, data tmtypes.TMEventData) {
+	s.mu.Lock()
+	if h := eventHeight(data); h > sub.lastHeight {
+		sub.lastHeight = h
+	}
+	s.mu.Unlock()
+	sub.handler(data)
+}
+
+// eventHeight returns the height of the event, 0 if the event has none
+func eventHeight(data tmtypes.TMEventData) int64 {
+	switch data := data.(type) {
+	case tmtypes.EventDataTx:
+		return data.Height
+	case tmtypes.EventDataNewBlock:
+		if data.Block != nil {
+			return data.Block.Height
+		}
+	case tmtypes.EventDataNewBlockHeader:
+		return data.Header.Height
+	}
+	return 0
+}
+
+// splitEventQuery returns the event type of the query and its other conditions
+func splitEventQuery(query string) (eventType string, conditions []string) {
+	for _, condition := range strings.Split(query, " AND ") {
+		condition = strings.TrimSpace(condition)
+		if matches := eventTypeRegexp.FindStringSubmatch(condition); len(matches) == 2 {
+			eventType = matches[1]
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	return eventType, conditions
+}
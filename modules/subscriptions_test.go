@@ -0,0 +1,203 @@
+package modules
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpc "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type backfillNode struct {
+	rpc.Client
+	queries []string
+}
+
+func (b *backfillNode) TxSearch(_ context.Context, query string, _ bool, page, perPage *int, _ string) (*ctypes.ResultTxSearch, error) {
+	b.queries = append(b.queries, query)
+	res := &ctypes.ResultTxSearch{TotalCount: 3}
+	for i := (*page - 1) * *perPage; i < res.TotalCount && i < *page**perPage; i++ {
+		res.Txs = append(res.Txs, &ctypes.ResultTx{Height: int64(11 + i)})
+	}
+	return res, nil
+}
+
+func (b *backfillNode) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	return &ctypes.ResultBlock{Block: &tmtypes.Block{Header: tmtypes.Header{Height: *height}}}, nil
+}
+
+func (b *backfillNode) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	return &ctypes.ResultBlockResults{Height: *height}, nil
+}
+
+func TestSubscriptionsCatchUp(t *testing.T) {
+	fake := &backfillNode{}
+	n := &node{remote: "fake", client: fake}
+
+	var events []sdk.SubscriptionEvent
+	s := newSubscriptions(nil, true, func(event sdk.SubscriptionEvent) {
+		events = append(events, event)
+	}, log.NewNopLogger())
+
+	var heights []int64
+	handler := func(data tmtypes.TMEventData) { heights = append(heights, eventHeight(data)) }
+
+	// the txs are searched without the event type, the live events received meanwhile
+	// are delivered after the backfill unless they have been backfilled
+	sub := &subscription{id: "tx", query: "tm.event='Tx' AND message.sender='iaa1'", handler: handler, lastHeight: 10, resuming: true}
+	s.subs[sub.id] = sub
+	s.deliver(sub.query, tmtypes.EventDataTx{TxResult: abci.TxResult{Height: 13}})
+	s.deliver(sub.query, tmtypes.EventDataTx{TxResult: abci.TxResult{Height: 14}})
+	require.Empty(t, heights)
+
+	s.catchUp(n, sub, 13)
+	require.Equal(t, []int64{11, 12, 13, 14}, heights)
+	require.Equal(t, []string{"message.sender='iaa1' AND tx.height>=11 AND tx.height<=13"}, fake.queries)
+	require.Equal(t, []sdk.SubscriptionEvent{{
+		Type:       sdk.SubscriptionBackfilled,
+		Node:       "fake",
+		Query:      sub.query,
+		Height:     13,
+		FromHeight: 11,
+	}}, events)
+	require.False(t, sub.resuming)
+	require.Equal(t, int64(14), sub.lastHeight)
+
+	// the blocks are fetched one by one
+	heights = nil
+	sub = &subscription{id: "block", query: "tm.event = 'NewBlockHeader'", handler: handler, lastHeight: 20, resuming: true}
+	s.subs[sub.id] = sub
+	s.catchUp(n, sub, 23)
+	require.Equal(t, []int64{21, 22, 23}, heights)
+
+	// the blocks filtered by other conditions can not be backfilled
+	events = nil
+	sub = &subscription{id: "filtered", query: "tm.event='NewBlock' AND mint.amount='1'", handler: handler, lastHeight: 20, resuming: true}
+	s.catchUp(n, sub, 23)
+	require.Len(t, events, 1)
+	require.Error(t, events[0].Error)
+}
+
+func TestSubscriptionsDialFailure(t *testing.T) {
+	pool := newNodePool(dialNodes([]string{"tcp://127.0.0.1:1"}, 1, log.NewNopLogger()),
+		time.Second, time.Second, 5, log.NewNopLogger())
+	s := newSubscriptions(pool, false, nil, log.NewNopLogger())
+
+	err := s.subscribe(context.Background(), "id", "tm.event='NewBlock'", func(tmtypes.TMEventData) {}, nil)
+	require.Error(t, err)
+	require.Empty(t, s.subs)
+}
+
+// trackingListener records the accepted connections so that the test can drop them
+type trackingListener struct {
+	net.Listener
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (l *trackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.mu.Lock()
+		l.conns = append(l.conns, conn)
+		l.mu.Unlock()
+	}
+	return conn, err
+}
+
+func (l *trackingListener) drop() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, conn := range l.conns {
+		_ = conn.Close()
+	}
+	l.conns = nil
+}
+
+func TestSubscriptionsReconnect(t *testing.T) {
+	var mu sync.Mutex
+	var subscribed []string
+	var conn rpctypes.WSRPCConnection
+	var req *rpctypes.RPCRequest
+
+	routes := map[string]*rpcserver.RPCFunc{
+		"subscribe": rpcserver.NewWSRPCFunc(func(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			subscribed = append(subscribed, query)
+			conn, req = ctx.WSConn, ctx.JSONReq
+			return &ctypes.ResultSubscribe{}, nil
+		}, "query"),
+		"status": rpcserver.NewRPCFunc(func(ctx *rpctypes.Context) (*ctypes.ResultStatus, error) {
+			return &ctypes.ResultStatus{SyncInfo: ctypes.SyncInfo{LatestBlockHeight: 10}}, nil
+		}, ""),
+	}
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, routes, log.NewNopLogger())
+	mux.HandleFunc("/websocket", rpcserver.NewWebsocketManager(routes).WebsocketHandler)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	tracking := &trackingListener{Listener: lis}
+	server := httptest.NewUnstartedServer(mux)
+	server.Listener = tracking
+	server.Start()
+	defer server.Close()
+
+	logger := log.NewNopLogger()
+	remote := "tcp://" + lis.Addr().String()
+	pool := newNodePool(dialNodes([]string{remote}, 5, logger), time.Second, 5*time.Second, 5, logger)
+	require.NoError(t, pool.Start())
+
+	lifecycle := make(chan sdk.SubscriptionEvent, 10)
+	s := newSubscriptions(pool, false, func(event sdk.SubscriptionEvent) { lifecycle <- event }, logger)
+
+	received := make(chan int64, 10)
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlockHeader).String()
+	require.NoError(t, s.subscribe(context.Background(), "id", query, func(data tmtypes.TMEventData) {
+		received <- eventHeight(data)
+	}, nil))
+
+	publish := func(height int64) {
+		require.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return conn != nil
+		}, 5*time.Second, 10*time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		event := ctypes.ResultEvent{Query: query, Data: tmtypes.EventDataNewBlockHeader{Header: tmtypes.Header{Height: height}}}
+		require.NoError(t, conn.WriteRPCResponse(context.Background(), rpctypes.NewRPCSuccessResponse(req.ID, event)))
+		conn = nil
+	}
+	publish(9)
+	require.Equal(t, int64(9), <-received)
+
+	// the connection drops, it is dialed again and the query is registered again
+	tracking.drop()
+	require.Equal(t, sdk.SubscriptionDisconnected, (<-lifecycle).Type)
+	reconnected := <-lifecycle
+	require.Equal(t, sdk.SubscriptionReconnected, reconnected.Type)
+	require.Equal(t, int64(10), reconnected.Height)
+	require.Equal(t, sdk.SubscriptionEvent{Type: sdk.SubscriptionResubscribed, Node: remote, Query: query}, <-lifecycle)
+
+	publish(11)
+	require.Equal(t, int64(11), <-received)
+	mu.Lock()
+	require.Equal(t, []string{query, query}, subscribed)
+	mu.Unlock()
+}
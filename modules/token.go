@@ -8,6 +8,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/transfer"
 	"github.com/irisnet/irishub-sdk-go/modules/token"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 	"github.com/irisnet/irishub-sdk-go/utils/cache"
@@ -16,25 +17,29 @@ import (
 type tokenQuery struct {
 	q sdk.Queries
 	sdk.GRPCClient
+	ctx context.Context
 	cdc codec.Marshaler
 	log.Logger
 	cache.Cache
 }
 
 func (l tokenQuery) QueryToken(denom string) (sdk.Token, error) {
+	if transfer.IsIBCDenom(denom) || transfer.IsDenomPath(denom) {
+		return l.queryIBCToken(denom)
+	}
+
 	denom = strings.ToLower(denom)
 	if t, err := l.Get(l.prefixKey(denom)); err == nil {
 		return t.(sdk.Token), nil
 	}
 
 	conn, err := l.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return sdk.Token{}, sdk.Wrap(err)
 	}
 
 	response, err := token.NewQueryClient(conn).Token(
-		context.Background(),
+		l.ctx,
 		&token.QueryTokenRequest{Denom: denom},
 	)
 	if err != nil {
@@ -53,6 +58,62 @@ func (l tokenQuery) QueryToken(denom string) (sdk.Token, error) {
 	return token, nil
 }
 
+// queryIBCToken resolves the vouchers received through IBC from their ibc/{hash} denom or the
+// path of their trace, e.g. transfer/channel-0/uatom. The min unit of the token is the ibc denom
+// and its symbol is the path of the base denom. A base denom received through a channel belongs
+// to the counterparty chain, so the token of the same denom on this chain is only used when the
+// trace has no path. The token is cached under the requested denom as well.
+func (l tokenQuery) queryIBCToken(denom string) (sdk.Token, error) {
+	if t, err := l.Get(l.prefixKey(denom)); err == nil {
+		return t.(sdk.Token), nil
+	}
+
+	trace := transfer.ParseDenomTrace(denom)
+	if transfer.IsIBCDenom(denom) {
+		hash, err := transfer.ParseIBCDenom(denom)
+		if err != nil {
+			return sdk.Token{}, sdk.Wrap(err)
+		}
+
+		conn, err := l.GenConn()
+		if err != nil {
+			return sdk.Token{}, sdk.Wrap(err)
+		}
+
+		response, err := transfer.NewQueryClient(conn).DenomTrace(
+			l.ctx,
+			&transfer.QueryDenomTraceRequest{Hash: hash},
+		)
+		if err != nil {
+			l.Debug("client query denom trace failed",
+				" denom ", denom,
+				" err ", err.Error())
+			return sdk.Token{}, nil
+		}
+		trace = *response.DenomTrace
+	}
+
+	token := sdk.Token{
+		Symbol:  trace.GetFullDenomPath(),
+		Name:    trace.GetFullDenomPath(),
+		MinUnit: trace.IBCDenom(),
+	}
+	if len(trace.Path) == 0 {
+		baseToken, err := l.QueryToken(trace.BaseDenom)
+		if err != nil {
+			return sdk.Token{}, sdk.Wrap(err)
+		}
+		if len(baseToken.Symbol) > 0 {
+			token = baseToken
+		}
+	}
+	l.SaveTokens(token)
+	if err := l.Set(l.prefixKey(denom), token); err != nil {
+		l.Debug("cache token failed", "denom", denom)
+	}
+	return token, nil
+}
+
 func (l tokenQuery) SaveTokens(tokens ...sdk.Token) {
 	for _, t := range tokens {
 		err1 := l.Set(l.prefixKey(t.Symbol), t)
@@ -79,6 +140,23 @@ func (l tokenQuery) ToMinCoin(coins ...sdk.DecCoin) (dstCoins sdk.Coins, err sdk
 	return dstCoins.Sort(), nil
 }
 
+// ToMinDecCoin converts the coins to the min unit without truncation, e.g. gas prices
+func (l tokenQuery) ToMinDecCoin(coins ...sdk.DecCoin) (dstCoins sdk.DecCoins, err sdk.Error) {
+	for _, coin := range coins {
+		token, err := l.QueryToken(coin.Denom)
+		if err != nil {
+			return nil, sdk.Wrap(err)
+		}
+
+		minCoin, err := token.GetCoinType().ConvertToMinDecCoin(coin)
+		if err != nil {
+			return nil, sdk.Wrap(err)
+		}
+		dstCoins = append(dstCoins, minCoin)
+	}
+	return dstCoins.Sort(), nil
+}
+
 func (l tokenQuery) ToMainCoin(coins ...sdk.Coin) (dstCoins sdk.DecCoins, err sdk.Error) {
 	for _, coin := range coins {
 		token, err := l.QueryToken(coin.Denom)
@@ -0,0 +1,53 @@
+package token
+
+import (
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type IssueTokenEvent struct {
+	Symbol  string `event:"symbol"`
+	Creator string `event:"creator"`
+}
+
+func (IssueTokenEvent) EventType() string { return "issue_token" }
+
+type EditTokenEvent struct {
+	Symbol string `event:"symbol"`
+	Owner  string `event:"owner"`
+}
+
+func (EditTokenEvent) EventType() string { return "edit_token" }
+
+type MintTokenEvent struct {
+	Symbol    string `event:"symbol"`
+	Amount    string `event:"amount"`
+	Recipient string `event:"recipient"`
+}
+
+func (MintTokenEvent) EventType() string { return "mint_token" }
+
+type BurnTokenEvent struct {
+	Symbol string `event:"symbol"`
+	Amount string `event:"amount"`
+}
+
+func (BurnTokenEvent) EventType() string { return "burn_token" }
+
+type TransferTokenOwnerEvent struct {
+	Symbol   string `event:"symbol"`
+	Owner    string `event:"owner"`
+	DstOwner string `event:"dst_owner"`
+}
+
+func (TransferTokenOwnerEvent) EventType() string { return "transfer_token_owner" }
+
+// RegisterEvents registers the typed events of the token module
+func RegisterEvents(registry *sdk.EventRegistry) {
+	registry.RegisterEvents(
+		IssueTokenEvent{},
+		EditTokenEvent{},
+		MintTokenEvent{},
+		BurnTokenEvent{},
+		TransferTokenOwnerEvent{},
+	)
+}
@@ -1,11 +1,14 @@
 package token
 
 import (
+	"context"
+
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
 type Client interface {
 	sdk.Module
+	WithContext(ctx context.Context) Client
 
 	IssueToken(req IssueTokenRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
 	EditToken(req EditTokenRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error)
@@ -16,6 +19,8 @@ type Client interface {
 	QueryTokens(owner string) (sdk.Tokens, error)
 	QueryFees(symbol string) (QueryFeesResp, error)
 	QueryParams() (QueryParamsResp, error)
+	SubscribeIssueTokenTx(owner string, callback EventMsgIssueTokenCallback) (sdk.Subscription, sdk.Error)
+	SubscribeMintTokenTx(symbol, to string, callback EventMsgMintTokenCallback) (sdk.Subscription, sdk.Error)
 }
 
 type IssueTokenRequest struct {
@@ -48,3 +53,7 @@ type QueryParamsResp struct {
 	IssueTokenBaseFee string `json:"issue_token_base_fee"` // e.g., 300000*10^18iris-atto
 	MintTokenFeeRatio string `json:"mint_token_fee_ratio"` // e.g., 10%
 }
+
+type EventMsgIssueTokenCallback func(msg *MsgIssueToken, data sdk.EventDataMsg)
+
+type EventMsgMintTokenCallback func(msg *MsgMintToken, data sdk.EventDataMsg)
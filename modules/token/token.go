@@ -6,6 +6,7 @@ package token
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
 	"github.com/irisnet/irishub-sdk-go/codec/types"
@@ -32,6 +33,15 @@ func (t tokenClient) RegisterInterfaceTypes(registry types.InterfaceRegistry) {
 	RegisterInterfaces(registry)
 }
 
+func (t tokenClient) RegisterEvents(registry *sdk.EventRegistry) {
+	RegisterEvents(registry)
+}
+
+// WithContext returns a copy of the client whose calls are bound to ctx
+func (t tokenClient) WithContext(ctx context.Context) Client {
+	return NewClient(t.BaseClient.WithContext(ctx), t.Marshaler)
+}
+
 func (t tokenClient) IssueToken(req IssueTokenRequest, baseTx sdk.BaseTx) (sdk.ResultTx, sdk.Error) {
 	owner, err := t.QueryAddress(baseTx.From, baseTx.Password)
 	if err != nil {
@@ -125,7 +135,6 @@ func (t tokenClient) QueryTokens(owner string) (sdk.Tokens, error) {
 	}
 
 	conn, err := t.GenConn()
-	defer func() { _ = conn.Close() }()
 
 	if err != nil {
 		return sdk.Tokens{}, sdk.Wrap(err)
@@ -135,7 +144,7 @@ func (t tokenClient) QueryTokens(owner string) (sdk.Tokens, error) {
 		Owner: ownerAddr,
 	}
 
-	res, err := NewQueryClient(conn).Tokens(context.Background(), request)
+	res, err := NewQueryClient(conn).Tokens(t.Context(), request)
 	if err != nil {
 		return sdk.Tokens{}, err
 	}
@@ -156,7 +165,6 @@ func (t tokenClient) QueryTokens(owner string) (sdk.Tokens, error) {
 
 func (t tokenClient) QueryFees(symbol string) (QueryFeesResp, error) {
 	conn, err := t.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryFeesResp{}, sdk.Wrap(err)
 	}
@@ -165,7 +173,7 @@ func (t tokenClient) QueryFees(symbol string) (QueryFeesResp, error) {
 		Symbol: symbol,
 	}
 
-	res, err := NewQueryClient(conn).Fees(context.Background(), request)
+	res, err := NewQueryClient(conn).Fees(t.Context(), request)
 	if err != nil {
 		return QueryFeesResp{}, err
 	}
@@ -175,13 +183,12 @@ func (t tokenClient) QueryFees(symbol string) (QueryFeesResp, error) {
 
 func (t tokenClient) QueryParams() (QueryParamsResp, error) {
 	conn, err := t.GenConn()
-	defer func() { _ = conn.Close() }()
 	if err != nil {
 		return QueryParamsResp{}, sdk.Wrap(err)
 	}
 
 	res, err := NewQueryClient(conn).Params(
-		context.Background(),
+		t.Context(),
 		&QueryParamsRequest{},
 	)
 	if err != nil {
@@ -190,3 +197,30 @@ func (t tokenClient) QueryParams() (QueryParamsResp, error) {
 
 	return res.Params.Convert().(QueryParamsResp), nil
 }
+
+// SubscribeIssueTokenTx subscribes the tokens issued by the owner, an empty owner matches any
+func (t tokenClient) SubscribeIssueTokenTx(owner string, callback EventMsgIssueTokenCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgIssueToken{}.Type())
+	if len(owner) > 0 {
+		builder.AddCondition(sdk.NewCond(sdk.EventTypeMessage, sdk.AttributeKeySender).EQ(sdk.EventValue(owner)))
+	}
+	return sdk.SubscribeMsgs(t.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgIssueToken)
+		if !ok || (len(owner) > 0 && msg.Owner != owner) {
+			return
+		}
+		callback(msg, data)
+	})
+}
+
+// SubscribeMintTokenTx subscribes the mints of the token to the recipient, an empty symbol or recipient matches any
+func (t tokenClient) SubscribeMintTokenTx(symbol, to string, callback EventMsgMintTokenCallback) (sdk.Subscription, sdk.Error) {
+	builder := sdk.NewMsgQueryBuilder(MsgMintToken{}.Type())
+	return sdk.SubscribeMsgs(t.BaseClient, builder, func(data sdk.EventDataMsg) {
+		msg, ok := data.Msg.(*MsgMintToken)
+		if !ok || (len(symbol) > 0 && !strings.EqualFold(msg.Symbol, symbol)) || (len(to) > 0 && msg.To != to) {
+			return
+		}
+		callback(msg, data)
+	})
+}
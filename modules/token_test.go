@@ -0,0 +1,71 @@
+package modules
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/irisnet/irishub-sdk-go/modules/ibc/transfer"
+	"github.com/irisnet/irishub-sdk-go/modules/token"
+	"github.com/irisnet/irishub-sdk-go/utils/cache"
+)
+
+type transferService struct {
+	transfer.UnimplementedQueryServer
+	trace transfer.DenomTrace
+	calls int
+}
+
+func (s *transferService) DenomTrace(context.Context, *transfer.QueryDenomTraceRequest) (*transfer.QueryDenomTraceResponse, error) {
+	s.calls++
+	return &transfer.QueryDenomTraceResponse{DenomTrace: &s.trace}, nil
+}
+
+type tokenService struct {
+	token.UnimplementedQueryServer
+	calls int
+}
+
+func (s *tokenService) Token(context.Context, *token.QueryTokenRequest) (*token.QueryTokenResponse, error) {
+	s.calls++
+	return &token.QueryTokenResponse{}, nil
+}
+
+func TestQueryIBCToken(t *testing.T) {
+	trace := transfer.ParseDenomTrace("transfer/channel-0/uiris")
+	transfers, tokens := &transferService{trace: trace}, &tokenService{}
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	transfer.RegisterQueryServer(s, transfers)
+	token.RegisterQueryServer(s, tokens)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	q := tokenQuery{
+		GRPCClient: NewGRPCClient("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		})),
+		ctx:    context.Background(),
+		Logger: log.NewNopLogger(),
+		Cache:  cache.NewCache(10, true),
+	}
+
+	// the base denom of a voucher belongs to the counterparty chain, the uiris of this chain
+	// is not looked up, and the trace is queried once for every spelling of the denom
+	for _, denom := range []string{trace.IBCDenom(), strings.ToLower(trace.IBCDenom()), "transfer/channel-0/uiris"} {
+		voucher, err := q.QueryToken(denom)
+		require.NoError(t, err)
+		require.Equal(t, "transfer/channel-0/uiris", voucher.Symbol)
+		require.Equal(t, trace.IBCDenom(), voucher.MinUnit)
+		require.Zero(t, voucher.Scale)
+	}
+	require.Equal(t, 2, transfers.calls)
+	require.Zero(t, tokens.calls)
+}
@@ -1,20 +1,24 @@
 package modules
 
 import (
-	"context"
 	"encoding/hex"
 	"errors"
-	"strings"
+	"fmt"
+	"sort"
 	"time"
 
-	"github.com/gogo/protobuf/jsonpb"
-
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 
 	clienttx "github.com/irisnet/irishub-sdk-go/client/tx"
 	sdk "github.com/irisnet/irishub-sdk-go/types"
 )
 
+const (
+	defaultTxSearchPage    = 1
+	defaultTxSearchPerPage = 30
+	maxTxSearchPerPage     = 100
+)
+
 // QueryTx returns the tx info
 func (base baseClient) QueryTx(hash string) (sdk.ResultQueryTx, error) {
 	tx, err := hex.DecodeString(hash)
@@ -22,7 +26,7 @@ func (base baseClient) QueryTx(hash string) (sdk.ResultQueryTx, error) {
 		return sdk.ResultQueryTx{}, err
 	}
 
-	res, err := base.Tx(context.Background(), tx, true)
+	res, err := base.Tx(base.Context(), tx, true)
 	if err != nil {
 		return sdk.ResultQueryTx{}, err
 	}
@@ -34,13 +38,26 @@ func (base baseClient) QueryTx(hash string) (sdk.ResultQueryTx, error) {
 	return base.parseTxResult(res, resBlocks[res.Height])
 }
 
+// QueryTxs returns the txs matching the builder in ascending order. The queries of an OR query are
+// searched separately, and their first page*size txs merged by height and deduplicated; the
+// total is then an upper bound of the number of distinct txs.
 func (base baseClient) QueryTxs(builder *sdk.EventQueryBuilder, page, size *int) (sdk.ResultSearchTxs, error) {
-	query := builder.Build()
-	if len(query) == 0 {
+	if err := builder.Err(); err != nil {
+		return sdk.ResultSearchTxs{}, err
+	}
+
+	queries := builder.Queries()
+	if len(queries) == 0 {
 		return sdk.ResultSearchTxs{}, errors.New("must declare at least one tag to search")
 	}
 
-	res, err := base.TxSearch(context.Background(), query, true, page, size, "asc")
+	var res *ctypes.ResultTxSearch
+	var err error
+	if len(queries) == 1 {
+		res, err = base.TxSearch(base.Context(), queries[0], true, page, size, "asc")
+	} else {
+		res, err = base.searchTxsAny(queries, page, size)
+	}
 	if err != nil {
 		return sdk.ResultSearchTxs{}, err
 	}
@@ -65,13 +82,209 @@ func (base baseClient) QueryTxs(builder *sdk.EventQueryBuilder, page, size *int)
 	}, nil
 }
 
+// searchTxsAny returns the page of the txs matching any of the queries
+func (base baseClient) searchTxsAny(queries []string, page, size *int) (*ctypes.ResultTxSearch, error) {
+	pageNum, perPage := defaultTxSearchPage, defaultTxSearchPerPage
+	if page != nil && *page > 0 {
+		pageNum = *page
+	}
+	if size != nil && *size > 0 {
+		perPage = *size
+	}
+	if perPage > maxTxSearchPerPage {
+		perPage = maxTxSearchPerPage
+	}
+	limit := pageNum * perPage
+
+	var total int
+	seen := make(map[string]bool)
+	var txs []*ctypes.ResultTx
+	for _, query := range queries {
+		var found int
+		for p := 1; found < limit; p++ {
+			pp := maxTxSearchPerPage
+			res, err := base.TxSearch(base.Context(), query, true, &p, &pp, "asc")
+			if err != nil {
+				return nil, err
+			}
+			if p == 1 {
+				total += res.TotalCount
+			}
+			for _, tx := range res.Txs {
+				found++
+				if seen[tx.Hash.String()] {
+					total--
+					continue
+				}
+				seen[tx.Hash.String()] = true
+				txs = append(txs, tx)
+			}
+			if len(res.Txs) < pp || found >= res.TotalCount {
+				break
+			}
+		}
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		if txs[i].Height != txs[j].Height {
+			return txs[i].Height < txs[j].Height
+		}
+		return txs[i].Index < txs[j].Index
+	})
+
+	start := (pageNum - 1) * perPage
+	if start > len(txs) {
+		start = len(txs)
+	}
+	end := start + perPage
+	if end > len(txs) {
+		end = len(txs)
+	}
+	return &ctypes.ResultTxSearch{Txs: txs[start:end], TotalCount: total}, nil
+}
+
+// NewTxIterator iterates the txs matching the builder in ascending order, or descending with
+// opts.Desc. The queries of an OR query are walked side by side and merged by height, a tx
+// matching several of them being iterated once. The descending pages are pinned to the latest
+// height when the iteration starts, so that the new txs don't shift them.
+func (base baseClient) NewTxIterator(builder *sdk.EventQueryBuilder, opts sdk.TxSearchOptions) *sdk.PageIterator {
+	perPage := int(opts.PageSize)
+	if perPage <= 0 || perPage > maxTxSearchPerPage {
+		perPage = maxTxSearchPerPage
+	}
+	orderBy := "asc"
+	if opts.Desc {
+		orderBy = "desc"
+	}
+
+	var cursors []*txSearchCursor
+	for _, query := range builder.Queries() {
+		cursors = append(cursors, &txSearchCursor{
+			base:    base,
+			query:   query,
+			orderBy: orderBy,
+			perPage: perPage,
+		})
+	}
+
+	var lastHash string
+	pinned := !opts.Desc
+	fetch := func(_ []byte, _ uint64) ([]interface{}, []byte, error) {
+		if err := builder.Err(); err != nil {
+			return nil, nil, err
+		}
+		if len(cursors) == 0 {
+			return nil, nil, errors.New("must declare at least one tag to search")
+		}
+
+		if !pinned {
+			status, err := base.Status(base.Context())
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, c := range cursors {
+				c.query = fmt.Sprintf("%s AND %s<=%d", c.query, sdk.TxHeightKey, status.SyncInfo.LatestBlockHeight)
+			}
+			pinned = true
+		}
+
+		var txs []*ctypes.ResultTx
+		for len(txs) < perPage {
+			var next *txSearchCursor
+			var nextTx *ctypes.ResultTx
+			for _, c := range cursors {
+				tx, err := c.peek()
+				if err != nil {
+					return nil, nil, err
+				}
+				if tx != nil && (nextTx == nil || txBefore(tx, nextTx, opts.Desc)) {
+					next, nextTx = c, tx
+				}
+			}
+			if next == nil {
+				break
+			}
+			next.txs = next.txs[1:]
+
+			// the txs are ordered by height and index, so a tx matching several
+			// queries is picked from each of them in a row
+			if nextTx.Hash.String() == lastHash {
+				continue
+			}
+			lastHash = nextTx.Hash.String()
+			txs = append(txs, nextTx)
+		}
+
+		resBlocks, err := base.getResultBlocks(txs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		items := make([]interface{}, len(txs))
+		for i, tx := range txs {
+			txInfo, err := base.parseTxResult(tx, resBlocks[tx.Height])
+			if err != nil {
+				return nil, nil, err
+			}
+			items[i] = txInfo
+		}
+
+		// the cursors keep their position, the key only tells whether more txs may follow
+		var nextKey []byte
+		if len(txs) == perPage {
+			nextKey = []byte(lastHash)
+		}
+		return items, nextKey, nil
+	}
+	return sdk.NewPageIterator(fetch, opts.PageOptions)
+}
+
+// txSearchCursor walks through the txs matching a query page by page
+type txSearchCursor struct {
+	base    baseClient
+	query   string
+	orderBy string
+	perPage int
+
+	page int
+	txs  []*ctypes.ResultTx
+	done bool
+}
+
+// peek returns the next tx of the cursor without consuming it, nil after the last one
+func (c *txSearchCursor) peek() (*ctypes.ResultTx, error) {
+	for len(c.txs) == 0 && !c.done {
+		page := c.page + 1
+		res, err := c.base.TxSearch(c.base.Context(), c.query, true, &page, &c.perPage, c.orderBy)
+		if err != nil {
+			return nil, err
+		}
+		c.page = page
+		c.txs = res.Txs
+		// requesting a page beyond the last one is an error
+		c.done = len(res.Txs) < c.perPage || page*c.perPage >= res.TotalCount
+	}
+	if len(c.txs) == 0 {
+		return nil, nil
+	}
+	return c.txs[0], nil
+}
+
+// txBefore reports whether the tx a comes before b in the order of the search
+func txBefore(a, b *ctypes.ResultTx, desc bool) bool {
+	if a.Height != b.Height {
+		return (a.Height < b.Height) != desc
+	}
+	return (a.Index < b.Index) != desc
+}
+
 func (base baseClient) QueryBlock(height int64) (sdk.BlockDetail, error) {
-	block, err := base.Block(context.Background(), &height)
+	block, err := base.Block(base.Context(), &height)
 	if err != nil {
 		return sdk.BlockDetail{}, err
 	}
 
-	blockResult, err := base.BlockResults(context.Background(), &height)
+	blockResult, err := base.BlockResults(base.Context(), &height)
 	if err != nil {
 		return sdk.BlockDetail{}, err
 	}
@@ -84,17 +297,17 @@ func (base baseClient) QueryBlock(height int64) (sdk.BlockDetail, error) {
 }
 
 func (base baseClient) EstimateTxGas(txBytes []byte) (uint64, error) {
-	res, err := base.ABCIQuery(context.Background(), "/app/simulate", txBytes)
+	bz, _, err := base.queryWithData("/app/simulate", txBytes)
 	if err != nil {
 		return 0, err
 	}
 
-	simRes, err := parseQueryResponse(res.Response.Value)
+	simRes, err := clienttx.ParseSimulationResponse(bz)
 	if err != nil {
 		return 0, err
 	}
 
-	adjusted := adjustGasEstimate(simRes.GasUsed, base.cfg.GasAdjustment)
+	adjusted := clienttx.AdjustGasEstimate(simRes.GasUsed, base.cfg.GasAdjustment)
 	return adjusted, nil
 }
 
@@ -106,19 +319,29 @@ func (base *baseClient) buildTx(msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *cli
 
 	txByte, err := builder.BuildAndSign(baseTx.From, msgs, false)
 	if err != nil {
+		// the cached sequence has been consumed by prepare
+		if base.cfg.Cached {
+			_ = base.removeCache(builder.Address())
+		}
 		return nil, builder, sdk.Wrap(err)
 	}
 
-	base.Logger().Debug("sign transaction success")
+	base.Logger().Debug("sign transaction success", "gas", builder.Gas())
 	return txByte, builder, nil
 }
 
-func (base *baseClient) buildTxWithAccount(addr string, accountNumber, sequence uint64, msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *clienttx.Factory, sdk.Error) {
+// buildTxWithAccount builds and signs the tx with the given account number and sequence of the sender,
+// the fee payer signs with the sequence of its ticket if any, or with the one of its account otherwise
+func (base *baseClient) buildTxWithAccount(addr string, accountNumber, sequence uint64, payer *sequenceTicket, msgs []sdk.Msg, baseTx sdk.BaseTx) ([]byte, *clienttx.Factory, sdk.Error) {
 	builder, err := base.prepareTemp(addr, accountNumber, sequence, baseTx)
 	if err != nil {
 		return nil, builder, sdk.Wrap(err)
 	}
 
+	if err := base.prepareFeePayment(builder, baseTx, false, payer); err != nil {
+		return nil, builder, sdk.Wrap(err)
+	}
+
 	txByte, err := builder.BuildAndSign(baseTx.From, msgs, false)
 	if err != nil {
 		return nil, builder, sdk.Wrap(err)
@@ -154,7 +377,7 @@ func (base baseClient) broadcastTx(txBytes []byte, mode sdk.BroadcastMode, simul
 // broadcastTxCommit broadcasts transaction bytes to a Tendermint node
 // and waits for a commit.
 func (base baseClient) broadcastTxCommit(tx []byte) (sdk.ResultTx, sdk.Error) {
-	res, err := base.BroadcastTxCommit(context.Background(), tx)
+	res, err := base.BroadcastTxCommit(base.Context(), tx)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
@@ -179,7 +402,7 @@ func (base baseClient) broadcastTxCommit(tx []byte) (sdk.ResultTx, sdk.Error) {
 // BroadcastTxSync broadcasts transaction bytes to a Tendermint node
 // synchronously.
 func (base baseClient) broadcastTxSync(tx []byte) (sdk.ResultTx, sdk.Error) {
-	res, err := base.BroadcastTxSync(context.Background(), tx)
+	res, err := base.BroadcastTxSync(base.Context(), tx)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
@@ -194,7 +417,7 @@ func (base baseClient) broadcastTxSync(tx []byte) (sdk.ResultTx, sdk.Error) {
 // BroadcastTxAsync broadcasts transaction bytes to a Tendermint node
 // asynchronously.
 func (base baseClient) broadcastTxAsync(tx []byte) (sdk.ResultTx, sdk.Error) {
-	res, err := base.BroadcastTxAsync(context.Background(), tx)
+	res, err := base.BroadcastTxAsync(base.Context(), tx)
 	if err != nil {
 		return sdk.ResultTx{}, sdk.Wrap(err)
 	}
@@ -206,7 +429,7 @@ func (base baseClient) getResultBlocks(resTxs []*ctypes.ResultTx) (map[int64]*ct
 	resBlocks := make(map[int64]*ctypes.ResultBlock)
 	for _, resTx := range resTxs {
 		if _, ok := resBlocks[resTx.Height]; !ok {
-			resBlock, err := base.Block(context.Background(), &resTx.Height)
+			resBlock, err := base.Block(base.Context(), &resTx.Height)
 			if err != nil {
 				return nil, err
 			}
@@ -231,6 +454,7 @@ func (base baseClient) parseTxResult(res *ctypes.ResultTx, resBlock *ctypes.Resu
 		Tx:     tx,
 		Result: sdk.TxResult{
 			Code:      res.TxResult.Code,
+			Codespace: res.TxResult.Codespace,
 			Log:       res.TxResult.Log,
 			GasWanted: res.TxResult.GasWanted,
 			GasUsed:   res.TxResult.GasUsed,
@@ -239,15 +463,3 @@ func (base baseClient) parseTxResult(res *ctypes.ResultTx, resBlock *ctypes.Resu
 		Timestamp: resBlock.Block.Time.Format(time.RFC3339),
 	}, nil
 }
-
-func adjustGasEstimate(estimate uint64, adjustment float64) uint64 {
-	return uint64(adjustment * float64(estimate))
-}
-
-func parseQueryResponse(bz []byte) (sdk.SimulationResponse, error) {
-	var simRes sdk.SimulationResponse
-	if err := jsonpb.Unmarshal(strings.NewReader(string(bz)), &simRes); err != nil {
-		return sdk.SimulationResponse{}, err
-	}
-	return simRes, nil
-}
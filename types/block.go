@@ -87,6 +87,7 @@ func ParseBlockResult(res *ctypes.ResultBlockResults) BlockResult {
 	for i, r := range res.TxsResults {
 		txResults[i] = TxResult{
 			Code:      r.Code,
+			Codespace: r.Codespace,
 			Log:       r.Log,
 			GasWanted: r.GasWanted,
 			GasUsed:   r.GasUsed,
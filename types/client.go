@@ -1,6 +1,10 @@
 package types
 
 import (
+	"context"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto"
 	"google.golang.org/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -14,6 +18,44 @@ type TxManager interface {
 	BuildAndSign(msg []Msg, baseTx BaseTx) ([]byte, Error)
 	SendBatch(msgs Msgs, baseTx BaseTx) ([]ResultTx, Error)
 	BuildAndSendWithAccount(addr string, accountNumber, sequence uint64, msg []Msg, baseTx BaseTx) (ResultTx, Error)
+	SequenceMetrics(address string) SequenceMetrics
+	QueryMinGasPrices() (DecCoins, Error)
+	TxConfirmer
+	MultisigManager
+}
+
+// TxConfirmer waits for a broadcast tx to be included in a block
+type TxConfirmer interface {
+	WaitForTx(ctx context.Context, res ResultTx, opts ConfirmOptions) (ResultQueryTx, Error)
+}
+
+// ConfirmOptions defines how long WaitForTx waits for the tx
+type ConfirmOptions struct {
+	// the tx is considered lost once the chain reaches this height, 0 waits until the context is done
+	TimeoutHeight int64
+	// period of polling the node, used as well when the websocket subscription fails
+	PollInterval time.Duration
+}
+
+// SequenceMetrics counts the sequences handed out to the concurrent senders of an account
+type SequenceMetrics struct {
+	Next    uint64 `json:"next"`    // the next sequence to be handed out
+	Pending int    `json:"pending"` // senders waiting for or holding a sequence
+	Retries uint64 `json:"retries"` // txs rebuilt with a new sequence
+	Resyncs uint64 `json:"resyncs"` // sequence mismatches reported by the node
+	Gaps    uint64 `json:"gaps"`    // sequences handed out whose txs never reached the mempool
+	// txs accepted by CheckTx and broadcast in commit mode, which are waiting to be committed
+	InFlight int `json:"in_flight"`
+}
+
+// MultisigManager builds and signs transactions of a k-of-n multisig account or with several signers,
+// such as a fee payer, every step exchanges json documents so that the co-signers can stay offline
+type MultisigManager interface {
+	BuildUnsignedTx(msgs []Msg, baseTx BaseTx) ([]byte, Error)
+	SignMultisigTx(unsignedTx []byte, accountNumber, sequence uint64, baseTx BaseTx) ([]byte, Error)
+	MultiSignTx(multisigPubKey crypto.PubKey, unsignedTx []byte, accountNumber, sequence uint64, signatures ...[]byte) ([]byte, Error)
+	CombineSignaturesTx(unsignedTx []byte, signatures ...[]byte) ([]byte, Error)
+	BroadcastSignedTx(signedTx []byte, mode BroadcastMode) (ResultTx, Error)
 }
 
 type Queries interface {
@@ -44,6 +86,9 @@ type AccountQuery interface {
 type TmQuery interface {
 	QueryTx(hash string) (ResultQueryTx, error)
 	QueryTxs(builder *EventQueryBuilder, page, size *int) (ResultSearchTxs, error)
+	// NewTxIterator iterates the ResultQueryTx of the txs matching the builder, the pages
+	// of the tx search are followed until the txs are exhausted
+	NewTxIterator(builder *EventQueryBuilder, opts TxSearchOptions) *PageIterator
 	QueryBlock(height int64) (BlockDetail, error)
 }
 
@@ -57,12 +102,20 @@ type TokenConvert interface {
 	ToMainCoin(coin ...Coin) (DecCoins, Error)
 }
 
+// ContextClient binds the calls of a client to a context, the deadline and the cancellation of
+// the context are propagated to the gRPC and Tendermint RPC calls
+type ContextClient interface {
+	Context() context.Context
+	WithContext(ctx context.Context) BaseClient
+}
+
 type Logger interface {
 	Logger() log.Logger
 	SetLogger(log.Logger)
 }
 
 type BaseClient interface {
+	ContextClient
 	TxManager
 	TokenManager
 	KeyManager
@@ -1,10 +1,17 @@
 package types
 
 import (
+	"crypto/tls"
 	"fmt"
 	"os"
+	"time"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
 	"github.com/irisnet/irishub-sdk-go/types/store"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
 )
 
 const (
@@ -17,6 +24,19 @@ const (
 	defaultMode          = Sync
 	defaultPath          = "$HOME/irishub-sdk-go/leveldb"
 	defaultGasAdjustment = 1.0
+	defaultSignMode      = signing.SignMode_SIGN_MODE_DIRECT
+
+	// the default enforcement policy of grpc servers rejects pings more frequent than every 5 minutes
+	defaultKeepAliveTime    = 5 * time.Minute
+	defaultKeepAliveTimeout = 20 * time.Second
+
+	defaultQueueSize = 100
+
+	defaultHealthCheckInterval = 10 * time.Second
+	defaultMaxHeightLag        = 5
+
+	// the default timeout_broadcast_tx_commit of Tendermint
+	defaultCommitTimeout = 10 * time.Second
 )
 
 type ClientConfig struct {
@@ -26,6 +46,12 @@ type ClientConfig struct {
 	// irishub grpc address
 	GRPCAddr string
 
+	// additional irishub node rpc addresses, the requests fail over among all the nodes
+	NodeURIs []string
+
+	// additional irishub grpc addresses, paired by position with the rpc addresses
+	GRPCAddrs []string
+
 	// irishub chain-id
 	ChainID string
 
@@ -35,10 +61,19 @@ type ClientConfig struct {
 	// Fee amount of point
 	Fee DecCoins
 
+	// gas prices used to derive the fee as ceil(gasPrice * gas) instead of the fixed Fee
+	GasPrices DecCoins
+
+	// whether to use the minimum gas prices of the node as GasPrices if none is given
+	AutoGasPrices bool
+
+	// maximum fee of a transaction, a tx whose fee exceeds it is not sent
+	MaxFee DecCoins
+
 	// PrivKeyArmor DAO Implements
 	KeyDAO store.KeyDAO
 
-	// Private key generation algorithm(sm2,secp256k1)
+	// Private key generation algorithm(secp256k1|ed25519|sm2)
 	Algo string
 
 	// Transaction broadcast Mode
@@ -47,6 +82,9 @@ type ClientConfig struct {
 	//Transaction broadcast timeout(seconds)
 	Timeout uint
 
+	//maximum time waited for a transaction of Commit mode to be committed once CheckTx accepts it
+	CommitTimeout time.Duration
+
 	//log level(trace|debug|info|warn|error|fatal|panic)
 	Level string
 
@@ -56,8 +94,47 @@ type ClientConfig struct {
 	//adjustment factor to be multiplied against the estimate returned by the tx simulation;
 	GasAdjustment float64
 
+	//whether to simulate the transaction and use the gas used multiplied by GasAdjustment as gas limit
+	AutoGas bool
+
 	//whether to enable caching
 	Cached bool
+
+	//sign mode used to sign the transaction(SIGN_MODE_DIRECT|SIGN_MODE_LEGACY_AMINO_JSON)
+	SignMode signing.SignMode
+
+	//external signer used to sign the transaction instead of the keys of KeyDAO
+	Signer Signer
+
+	//tls config of the grpc connection, the connection is insecure if not provided
+	TLSConfig *tls.Config
+
+	//keepalive parameters of the grpc connection
+	KeepAlive keepalive.ClientParameters
+
+	//interceptors invoked on every grpc call
+	UnaryInterceptors []grpc.UnaryClientInterceptor
+
+	//metadata sent with every grpc call, such as the authorization header
+	Metadata map[string]string
+
+	//additional dial options of the grpc connection
+	GRPCDialOptions []grpc.DialOption
+
+	//interval of the health checks of the nodes when several nodes are configured
+	HealthCheckInterval time.Duration
+
+	//maximum number of blocks a node may lag behind the highest known height before it is avoided
+	MaxHeightLag int64
+
+	//whether to deliver the blocks and txs missed by the subscriptions while the websocket was disconnected
+	Backfill bool
+
+	//handler notified of the disconnections, reconnections and backfills of the subscriptions
+	SubscriptionEventHandler SubscriptionEventHandler
+
+	//delivery of the events of the subscriptions made without options
+	Delivery DeliveryOptions
 }
 
 func NewClientConfig(uri, grpcAddr, chainID string, options ...Option) (ClientConfig, error) {
@@ -79,10 +156,17 @@ func NewClientConfig(uri, grpcAddr, chainID string, options ...Option) (ClientCo
 }
 
 func (cfg *ClientConfig) checkAndSetDefault() error {
+	if len(cfg.NodeURI) == 0 && len(cfg.NodeURIs) > 0 {
+		cfg.NodeURI, cfg.NodeURIs = cfg.NodeURIs[0], cfg.NodeURIs[1:]
+	}
 	if len(cfg.NodeURI) == 0 {
 		return fmt.Errorf("nodeURI is required")
 	}
 
+	if len(cfg.GRPCAddr) == 0 && len(cfg.GRPCAddrs) > 0 {
+		cfg.GRPCAddr, cfg.GRPCAddrs = cfg.GRPCAddrs[0], cfg.GRPCAddrs[1:]
+	}
+
 	if len(cfg.ChainID) == 0 {
 		return fmt.Errorf("chainID is required")
 	}
@@ -95,6 +179,14 @@ func (cfg *ClientConfig) checkAndSetDefault() error {
 		return err
 	}
 
+	if err := GasPricesOption(cfg.GasPrices)(cfg); err != nil {
+		return err
+	}
+
+	if err := MaxFeeOption(cfg.MaxFee)(cfg); err != nil {
+		return err
+	}
+
 	if err := AlgoOption(cfg.Algo)(cfg); err != nil {
 		return err
 	}
@@ -111,6 +203,10 @@ func (cfg *ClientConfig) checkAndSetDefault() error {
 		return err
 	}
 
+	if err := CommitTimeoutOption(cfg.CommitTimeout)(cfg); err != nil {
+		return err
+	}
+
 	if err := LevelOption(cfg.Level)(cfg); err != nil {
 		return err
 	}
@@ -119,7 +215,23 @@ func (cfg *ClientConfig) checkAndSetDefault() error {
 		return err
 	}
 
-	return GasAdjustmentOption(cfg.GasAdjustment)(cfg)
+	if err := GasAdjustmentOption(cfg.GasAdjustment)(cfg); err != nil {
+		return err
+	}
+
+	if err := KeepAliveOption(cfg.KeepAlive)(cfg); err != nil {
+		return err
+	}
+
+	if err := HealthCheckOption(cfg.HealthCheckInterval, cfg.MaxHeightLag)(cfg); err != nil {
+		return err
+	}
+
+	if err := DeliveryOption(cfg.Delivery)(cfg); err != nil {
+		return err
+	}
+
+	return SignModeOption(cfg.SignMode)(cfg)
 }
 
 type Option func(cfg *ClientConfig) error
@@ -135,6 +247,33 @@ func FeeOption(fee DecCoins) Option {
 	}
 }
 
+func GasPricesOption(gasPrices DecCoins) Option {
+	return func(cfg *ClientConfig) error {
+		if !gasPrices.Empty() && !gasPrices.IsValid() {
+			return fmt.Errorf("invalid gas prices: %s", gasPrices)
+		}
+		cfg.GasPrices = gasPrices
+		return nil
+	}
+}
+
+func AutoGasPricesOption(enabled bool) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.AutoGasPrices = enabled
+		return nil
+	}
+}
+
+func MaxFeeOption(maxFee DecCoins) Option {
+	return func(cfg *ClientConfig) error {
+		if !maxFee.Empty() && !maxFee.IsValid() {
+			return fmt.Errorf("invalid max fee: %s", maxFee)
+		}
+		cfg.MaxFee = maxFee
+		return nil
+	}
+}
+
 func KeyDAOOption(dao store.KeyDAO) Option {
 	return func(cfg *ClientConfig) error {
 		if dao == nil {
@@ -165,6 +304,9 @@ func AlgoOption(algo string) Option {
 		if algo == "" {
 			algo = defaultAlgo
 		}
+		if _, err := hd.NewSigningAlgoFromString(algo); err != nil {
+			return err
+		}
 		cfg.Algo = algo
 		return nil
 	}
@@ -190,6 +332,16 @@ func TimeoutOption(timeout uint) Option {
 	}
 }
 
+func CommitTimeoutOption(timeout time.Duration) Option {
+	return func(cfg *ClientConfig) error {
+		if timeout <= 0 {
+			timeout = defaultCommitTimeout
+		}
+		cfg.CommitTimeout = timeout
+		return nil
+	}
+}
+
 func LevelOption(level string) Option {
 	return func(cfg *ClientConfig) error {
 		if level == "" {
@@ -210,6 +362,13 @@ func MaxTxBytesOption(maxTxBytes uint64) Option {
 	}
 }
 
+func AutoGasOption(enabled bool) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.AutoGas = enabled
+		return nil
+	}
+}
+
 func GasAdjustmentOption(gasAdjustment float64) Option {
 	return func(cfg *ClientConfig) error {
 		if gasAdjustment <= 0 {
@@ -226,3 +385,134 @@ func CachedOption(enabled bool) Option {
 		return nil
 	}
 }
+
+func SignModeOption(signMode signing.SignMode) Option {
+	return func(cfg *ClientConfig) error {
+		switch signMode {
+		case signing.SignMode_SIGN_MODE_UNSPECIFIED:
+			signMode = defaultSignMode
+		case signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON:
+		default:
+			return fmt.Errorf("unsupported sign mode: %s", signMode)
+		}
+		cfg.SignMode = signMode
+		return nil
+	}
+}
+
+func SignerOption(signer Signer) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.Signer = signer
+		return nil
+	}
+}
+
+func TLSOption(tlsConfig *tls.Config) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.TLSConfig = tlsConfig
+		return nil
+	}
+}
+
+func KeepAliveOption(params keepalive.ClientParameters) Option {
+	return func(cfg *ClientConfig) error {
+		if params.Time <= 0 {
+			params.Time = defaultKeepAliveTime
+		}
+		if params.Timeout <= 0 {
+			params.Timeout = defaultKeepAliveTimeout
+		}
+		cfg.KeepAlive = params
+		return nil
+	}
+}
+
+func UnaryInterceptorOption(interceptors ...grpc.UnaryClientInterceptor) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.UnaryInterceptors = append(cfg.UnaryInterceptors, interceptors...)
+		return nil
+	}
+}
+
+func MetadataOption(md map[string]string) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.Metadata = md
+		return nil
+	}
+}
+
+func GRPCDialOption(opts ...grpc.DialOption) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.GRPCDialOptions = append(cfg.GRPCDialOptions, opts...)
+		return nil
+	}
+}
+
+func NodeURIsOption(uris ...string) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.NodeURIs = append(cfg.NodeURIs, uris...)
+		return nil
+	}
+}
+
+func GRPCAddrsOption(addrs ...string) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.GRPCAddrs = append(cfg.GRPCAddrs, addrs...)
+		return nil
+	}
+}
+
+func HealthCheckOption(interval time.Duration, maxHeightLag int64) Option {
+	return func(cfg *ClientConfig) error {
+		if interval <= 0 {
+			interval = defaultHealthCheckInterval
+		}
+		if maxHeightLag <= 0 {
+			maxHeightLag = defaultMaxHeightLag
+		}
+		cfg.HealthCheckInterval = interval
+		cfg.MaxHeightLag = maxHeightLag
+		return nil
+	}
+}
+
+// RPCEndpoints returns the NodeURI followed by the NodeURIs
+func (cfg ClientConfig) RPCEndpoints() []string {
+	return endpoints(cfg.NodeURI, cfg.NodeURIs)
+}
+
+// GRPCEndpoints returns the GRPCAddr followed by the GRPCAddrs
+func (cfg ClientConfig) GRPCEndpoints() []string {
+	return endpoints(cfg.GRPCAddr, cfg.GRPCAddrs)
+}
+
+func endpoints(first string, others []string) []string {
+	var all []string
+	for _, endpoint := range append([]string{first}, others...) {
+		if len(endpoint) > 0 {
+			all = append(all, endpoint)
+		}
+	}
+	return all
+}
+
+func BackfillOption(enabled bool) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.Backfill = enabled
+		return nil
+	}
+}
+
+func SubscriptionEventOption(handler SubscriptionEventHandler) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.SubscriptionEventHandler = handler
+		return nil
+	}
+}
+
+func DeliveryOption(opts DeliveryOptions) Option {
+	return func(cfg *ClientConfig) error {
+		cfg.Delivery = opts.WithDefaults()
+		return nil
+	}
+}
@@ -98,7 +98,7 @@ func GetError(codespace string, code uint32, log ...string) Error {
 // Wrap extends given error with an additional information.
 //
 // If the wrapped error does not provide ABCICode method (ie. stdlib errors),
-// it will be labeled as internal error.
+// it will be labeled as internal error, an Error is returned as is.
 //
 // If err is nil, this returns nil, avoiding the need for an if statement when
 // wrapping a error returned at the end of a function
@@ -106,6 +106,9 @@ func Wrap(err error) Error {
 	if err == nil {
 		return nil
 	}
+	if e, ok := err.(Error); ok {
+		return e
+	}
 
 	return sdkError{
 		codespace: errInvalid.Codespace(),
@@ -202,6 +205,7 @@ var v17CodeMap = map[uint32]Code{
 	21: TxTooLarge,
 	22: InvalidRequest,
 	23: InvalidRequest,
+	32: InvalidSequence,
 }
 
 func CatchPanic(fn func(errMsg string)) {
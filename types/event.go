@@ -1,11 +1,14 @@
 package types
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type WSClient interface {
@@ -13,6 +16,8 @@ type WSClient interface {
 	SubscribeTx(builder *EventQueryBuilder, handler EventTxHandler) (Subscription, Error)
 	SubscribeNewBlockHeader(handler EventNewBlockHeaderHandler) (Subscription, Error)
 	SubscribeValidatorSetUpdates(handler EventValidatorSetUpdatesHandler) (Subscription, Error)
+	SubscribeWithOptions(query string, opts DeliveryOptions, handler EventHandler) (Subscription, Error)
+	SubscribeChan(query string, opts DeliveryOptions) (Subscription, <-chan EventData, Error)
 	Unsubscribe(subscription Subscription) Error
 }
 
@@ -31,10 +36,77 @@ type Subscription struct {
 	Ctx   context.Context `json:"-"`
 	Query string          `json:"query"`
 	ID    string          `json:"id"`
+	// the subscriptions of the queries of an OR query, which are cancelled together
+	Subscriptions []Subscription `json:"subscriptions,omitempty"`
 }
 
 type EventHandler func(data EventData)
 
+// SubscriptionEventType is the type of a lifecycle event of the websocket subscriptions
+type SubscriptionEventType string
+
+const (
+	// the websocket connection to the node is lost
+	SubscriptionDisconnected SubscriptionEventType = "disconnected"
+	// the websocket connection is established again, possibly to another node
+	SubscriptionReconnected SubscriptionEventType = "reconnected"
+	// a query is registered again on the new connection
+	SubscriptionResubscribed SubscriptionEventType = "resubscribed"
+	// the events missed by a subscription during the disconnection have been delivered
+	SubscriptionBackfilled SubscriptionEventType = "backfilled"
+	// the queue of a subscription with the OverflowError policy is full, the subscription is cancelled
+	SubscriptionOverflowed SubscriptionEventType = "overflowed"
+)
+
+// OverflowPolicy decides what happens to an event when the queue of its subscription is full
+type OverflowPolicy int
+
+const (
+	// wait until the queue has room, the events of the node are not read meanwhile
+	OverflowBlock OverflowPolicy = iota
+	// drop the oldest queued event to make room for the new one
+	OverflowDropOldest
+	// cancel the subscription and report a SubscriptionOverflowed event
+	OverflowError
+)
+
+// DeliveryOptions controls how the events of a subscription are passed to its handler. The events are
+// queued and handled in order by a single worker by default. With several workers, the events with
+// the same Key are handled in order by the same worker.
+type DeliveryOptions struct {
+	// capacity of the queue of each worker
+	QueueSize int
+	Workers   int
+	// key of the event, the events without a Key are spread among the workers
+	Key      func(data EventData) string
+	Overflow OverflowPolicy
+}
+
+// WithDefaults returns the options with a queue of 100 events and a single worker if not set
+func (opts DeliveryOptions) WithDefaults() DeliveryOptions {
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = defaultQueueSize
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = 1
+	}
+	return opts
+}
+
+// SubscriptionEvent reports a change of the state of the subscriptions, Error is set if the step failed
+type SubscriptionEvent struct {
+	Type SubscriptionEventType
+	Node string
+	// the query of the subscription, empty for the events of the connection
+	Query string
+	// the latest height of the node when reconnected, or the range of the backfilled heights
+	Height     int64
+	FromHeight int64
+	Error      error
+}
+
+type SubscriptionEventHandler func(SubscriptionEvent)
+
 // EventData for SubscribeAny
 type EventData interface{}
 
@@ -43,6 +115,7 @@ type EventDataTx struct {
 	Hash   string   `json:"hash"`
 	Height int64    `json:"height"`
 	Index  uint32   `json:"index"`
+	// nil when the tx can't be decoded
 	Tx     Tx       `json:"tx"`
 	Result TxResult `json:"result"`
 }
@@ -54,6 +127,7 @@ func (tx EventDataTx) MarshalJson() []byte {
 
 type TxResult struct {
 	Code      uint32       `json:"code"`
+	Codespace string       `json:"codespace"`
 	Log       string       `json:"log"`
 	GasWanted int64        `json:"gas_wanted"`
 	GasUsed   int64        `json:"gas_used"`
@@ -141,6 +215,11 @@ type condition struct {
 	op    string
 }
 
+// Date is a date compared by the conditions of a query, the time of the day is ignored
+type Date time.Time
+
+var eventKeyRegexp = regexp.MustCompile(`^[^ \t\n\r\\()"'=><]+$`)
+
 // Cond return a condition object with a key
 func Cond(key EventKey) *condition {
 	return &condition{
@@ -175,9 +254,15 @@ func (c *condition) EQ(v EventValue) *condition {
 	return c.fill(v, "=")
 }
 
-//func (c *condition) Contains(v EventValue) *condition {
-//	return c.fill(v, "CONTAINS")
-//}
+// Contains matches the values containing the string v
+func (c *condition) Contains(v string) *condition {
+	return c.fill(v, "CONTAINS")
+}
+
+// Exists matches the events having the key
+func (c *condition) Exists() *condition {
+	return c.fill(nil, "EXISTS")
+}
 
 func (c *condition) fill(v EventValue, op string) *condition {
 	c.value = v
@@ -186,21 +271,85 @@ func (c *condition) fill(v EventValue, op string) *condition {
 }
 
 func (c *condition) String() string {
-	if len(c.key) == 0 || len(c.op) == 0 {
+	cond, err := c.build()
+	if err != nil {
 		return ""
 	}
+	return cond
+}
+
+// build returns the condition in the syntax of the Tendermint queries. The numbers, times and
+// dates can be compared with every operator, the strings are quoted and can only be compared
+// with = and CONTAINS, Tendermint does not allow the quotes in a string.
+func (c *condition) build() (string, error) {
+	if !eventKeyRegexp.MatchString(string(c.key)) {
+		return "", fmt.Errorf("invalid event key %q", c.key)
+	}
+	if len(c.op) == 0 {
+		return "", fmt.Errorf("missing operator of the event key %s", c.key)
+	}
+	if c.op == "EXISTS" {
+		return fmt.Sprintf("%s EXISTS", c.key), nil
+	}
+
+	value, quoted, err := formatEventValue(c.value)
+	if err != nil {
+		return "", fmt.Errorf("invalid value of the event key %s: %w", c.key, err)
+	}
+	switch {
+	case c.op == "CONTAINS" && !quoted:
+		return "", fmt.Errorf("the value of the event key %s compared by CONTAINS must be a string", c.key)
+	case c.op != "=" && c.op != "CONTAINS" && quoted:
+		return "", fmt.Errorf("the value of the event key %s compared by %s must be a number, a time or a date", c.key, c.op)
+	case c.op == "CONTAINS":
+		return fmt.Sprintf("%s CONTAINS %s", c.key, value), nil
+	}
+	return fmt.Sprintf("%s%s%s", c.key, c.op, value), nil
+}
+
+// formatEventValue formats the value in the syntax of the Tendermint queries, quoted is true for a string
+func formatEventValue(v EventValue) (value string, quoted bool, err error) {
+	switch v := v.(type) {
+	case nil:
+		return "", false, fmt.Errorf("missing value")
+	case time.Time:
+		return "TIME " + v.UTC().Format("2006-01-02T15:04:05Z"), false, nil
+	case Date:
+		return "DATE " + time.Time(v).Format("2006-01-02"), false, nil
+	}
 
-	switch c.value.(type) {
-	case int64, uint64:
-		return fmt.Sprintf("%s%s%d", c.key, c.op, c.value)
-	default:
-		return fmt.Sprintf("%s%s'%s'", c.key, c.op, c.value)
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return "", false, fmt.Errorf("negative number %d", rv.Int())
+		}
+		return strconv.FormatInt(rv.Int(), 10), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), false, nil
+	case reflect.Float32, reflect.Float64:
+		if rv.Float() < 0 {
+			return "", false, fmt.Errorf("negative number %v", rv.Float())
+		}
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), false, nil
+	}
+
+	str := fmt.Sprintf("%s", v)
+	if strings.ContainsAny(str, `'"`) {
+		return "", false, fmt.Errorf("quote in the string %s", str)
 	}
+	return "'" + str + "'", true, nil
 }
 
 //EventQueryBuilder is responsible for constructing listening conditions
 type EventQueryBuilder struct {
 	conditions []string
+	// the conditions of the other queries of an OR query
+	or [][]string
+	// whether the query of the builder is only made of the conditions shared by an OR query,
+	// which was started from a builder without conditions and is then not a query on its own
+	orOnly bool
+	err    error
 }
 
 func NewEventQueryBuilder() *EventQueryBuilder {
@@ -209,23 +358,90 @@ func NewEventQueryBuilder() *EventQueryBuilder {
 	}
 }
 
-//AddCondition is responsible for adding listening conditions
+//AddCondition is responsible for adding listening conditions, the invalid conditions are
+//reported by Err. The condition is added to every query of an OR query.
 func (eqb *EventQueryBuilder) AddCondition(c *condition) *EventQueryBuilder {
 	if c == nil {
 		return nil
 	}
-	eqb.conditions = append(eqb.conditions, c.String())
+
+	cond, err := c.build()
+	if err != nil {
+		if eqb.err == nil {
+			eqb.err = err
+		}
+		return eqb
+	}
+
+	eqb.conditions = append(eqb.conditions, cond)
+	for i := range eqb.or {
+		eqb.or[i] = append(eqb.or[i], cond)
+	}
+	return eqb
+}
+
+// HeightRange adds the conditions of the txs from the height from to the height to,
+// a height of 0 leaves the range open on its side
+func (eqb *EventQueryBuilder) HeightRange(from, to int64) *EventQueryBuilder {
+	if from > 0 {
+		eqb.AddCondition(Cond(TxHeightKey).GTE(from))
+	}
+	if to > 0 {
+		eqb.AddCondition(Cond(TxHeightKey).LTE(to))
+	}
+	return eqb
+}
+
+// Or adds the queries of the builders as alternatives to the query of eqb. Tendermint does not support
+// OR, so the queries are sent separately by QueryTxs and the subscriptions, which merge their results
+// and drop the duplicates. The conditions added afterwards are added to every query.
+func (eqb *EventQueryBuilder) Or(builders ...*EventQueryBuilder) *EventQueryBuilder {
+	if len(eqb.conditions) == 0 && len(eqb.or) == 0 {
+		eqb.orOnly = true
+	}
+	for _, b := range builders {
+		if b.err != nil && eqb.err == nil {
+			eqb.err = b.err
+		}
+		for _, conditions := range b.alternatives() {
+			eqb.or = append(eqb.or, append([]string(nil), conditions...))
+		}
+	}
 	return eqb
 }
 
-//Build is responsible for constructing the listening condition into a listening instruction identified by tendermint
+// alternatives returns the conditions of each query of the builder
+func (eqb *EventQueryBuilder) alternatives() [][]string {
+	if eqb.orOnly {
+		return eqb.or
+	}
+	return append([][]string{eqb.conditions}, eqb.or...)
+}
+
+// Err returns the error of the first invalid condition
+func (eqb *EventQueryBuilder) Err() error {
+	return eqb.err
+}
+
+//Build is responsible for constructing the listening condition into a listening instruction identified by tendermint,
+//it only returns the first query of an OR query, see Queries
 func (eqb *EventQueryBuilder) Build() string {
-	var buf bytes.Buffer
-	for _, condition := range eqb.conditions {
-		if buf.Len() > 0 {
-			buf.WriteString(" AND ")
+	if queries := eqb.Queries(); len(queries) > 0 {
+		return queries[0]
+	}
+	return ""
+}
+
+// Queries returns the distinct non-empty queries of an OR query, or the query of the builder
+func (eqb *EventQueryBuilder) Queries() []string {
+	var queries []string
+	seen := make(map[string]bool)
+	for _, conditions := range eqb.alternatives() {
+		query := strings.Join(conditions, " AND ")
+		if len(query) > 0 && !seen[query] {
+			seen[query] = true
+			queries = append(queries, query)
 		}
-		buf.WriteString(condition)
 	}
-	return buf.String()
+	return queries
 }
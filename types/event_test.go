@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventQueryBuilder(t *testing.T) {
+	at := time.Date(2021, 6, 1, 8, 30, 0, 0, time.FixedZone("CST", 8*3600))
+	builder := NewEventQueryBuilder().
+		AddCondition(NewCond("transfer", "recipient").EQ("iaa1recipient")).
+		AddCondition(NewCond("message", "action").Contains("send")).
+		AddCondition(NewCond("transfer", "amount").Exists()).
+		AddCondition(Cond("block.time").GTE(at)).
+		AddCondition(Cond("block.date").LE(Date(at))).
+		AddCondition(NewCond("proposal_vote", "proposal_id").EQ(7)).
+		HeightRange(100, 0)
+	require.NoError(t, builder.Err())
+	require.Equal(t, "transfer.recipient='iaa1recipient'"+
+		" AND message.action CONTAINS 'send'"+
+		" AND transfer.amount EXISTS"+
+		" AND block.time>=TIME 2021-06-01T00:30:00Z"+
+		" AND block.date<DATE 2021-06-01"+
+		" AND proposal_vote.proposal_id=7"+
+		" AND tx.height>=100", builder.Build())
+
+	invalid := []*condition{
+		NewCond("transfer", "recipient").EQ("it's"),
+		NewCond("transfer", "recipient").GTE("a"),
+		NewCond("transfer", "amount").Contains("1\"uiris"),
+		Cond("tx.height").GE(-1),
+		Cond("tx height").EQ(1),
+		Cond("tx.height"),
+	}
+	for _, c := range invalid {
+		b := NewEventQueryBuilder().AddCondition(c)
+		require.Error(t, b.Err(), c.key)
+		require.Empty(t, b.Build())
+	}
+	// an empty string is a valid value
+	require.NoError(t, NewEventQueryBuilder().AddCondition(NewCond("message", "sender").EQ("")).Err())
+}
+
+func TestEventQueryBuilderOr(t *testing.T) {
+	sender := NewEventQueryBuilder().AddCondition(NewCond("message", "sender").EQ("a"))
+	recipient := NewEventQueryBuilder().AddCondition(NewCond("transfer", "recipient").EQ("a"))
+	builder := sender.Or(recipient, NewEventQueryBuilder().AddCondition(NewCond("message", "sender").EQ("a"))).
+		AddCondition(Cond(TypeKey).EQ(TxValue)).
+		HeightRange(1, 10)
+
+	require.NoError(t, builder.Err())
+	require.Equal(t, []string{
+		"message.sender='a' AND tm.event='Tx' AND tx.height>=1 AND tx.height<=10",
+		"transfer.recipient='a' AND tm.event='Tx' AND tx.height>=1 AND tx.height<=10",
+	}, builder.Queries())
+	require.Equal(t, builder.Queries()[0], builder.Build())
+	// the alternatives are copied
+	require.Equal(t, "transfer.recipient='a'", recipient.Build())
+
+	// an OR query started from an empty builder doesn't match every tx
+	builder = NewEventQueryBuilder().Or(
+		NewEventQueryBuilder().AddCondition(NewCond("message", "sender").EQ("a")),
+		recipient,
+	).AddCondition(Cond(TypeKey).EQ(TxValue))
+	require.Equal(t, []string{
+		"message.sender='a' AND tm.event='Tx'",
+		"transfer.recipient='a' AND tm.event='Tx'",
+	}, builder.Queries())
+	require.Equal(t, builder.Queries()[0], builder.Build())
+	require.Equal(t, []string{"message.sender='a'", "transfer.recipient='a'"},
+		NewEventQueryBuilder().Or(NewEventQueryBuilder().Or(
+			NewEventQueryBuilder().AddCondition(NewCond("message", "sender").EQ("a")), recipient,
+		)).Queries())
+
+	require.Error(t, NewEventQueryBuilder().Or(NewEventQueryBuilder().AddCondition(Cond("").EQ(1))).Err())
+	require.Empty(t, NewEventQueryBuilder().Queries())
+}
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"context"
+
 	"github.com/tendermint/tendermint/crypto"
 
 	cdctypes "github.com/irisnet/irishub-sdk-go/codec/types"
+	"github.com/irisnet/irishub-sdk-go/types/store"
 )
 
 //The purpose of this interface is to convert the irishub system type to the user receiving type
@@ -22,12 +25,28 @@ type Module interface {
 	RegisterInterfaceTypes(registry cdctypes.InterfaceRegistry)
 }
 
-type KeyManager interface {
+// Signer signs the sign bytes with the key of name, the implementations can keep
+// the private keys outside the SDK process, such as in an external signer daemon
+type Signer interface {
 	Sign(name, password string, data []byte) ([]byte, crypto.PubKey, error)
+	Find(name, password string) (crypto.PubKey, AccAddress, error)
+}
+
+// ContextSigner is a Signer whose calls can be bound to a context, such as a remote signer,
+// the clients bind it to their own context
+type ContextSigner interface {
+	Signer
+	WithContext(ctx context.Context) Signer
+}
+
+type KeyManager interface {
+	Signer
 	Insert(name, password string) (string, string, error)
 	Recover(name, password, mnemonic, hdPath string) (string, error)
 	Import(name, password string, privKeyArmor string) (address string, err error)
 	Export(name, password string) (privKeyArmor string, err error)
 	Delete(name, password string) error
-	Find(name, password string) (crypto.PubKey, AccAddress, error)
+	List() ([]store.KeyMetadata, error)
+	Rename(name, newName, password string) error
+	ChangePassword(name, oldPassword, newPassword string) error
 }
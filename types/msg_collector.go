@@ -0,0 +1,69 @@
+package types
+
+import (
+	"context"
+	"sync"
+)
+
+const msgCollectorCodespace = "collector"
+
+// ErrMsgCollected is returned by the module calls whose msgs are collected by a MsgCollector
+// instead of being broadcast
+var ErrMsgCollected = register(msgCollectorCodespace, 1, "msgs collected instead of broadcast")
+
+type msgCollectorKey struct{}
+
+// MsgCollector collects the msgs built by the module calls bound to its context instead of
+// signing and broadcasting them, e.g. to execute them on behalf of a granter through authz.
+// The collected calls return ErrMsgCollected, and their BaseTx.From may be the bech32 address
+// of one of the signers of the collector, whose key doesn't need to be in the KeyDAO.
+type MsgCollector struct {
+	mtx     sync.Mutex
+	msgs    []Msg
+	signers []AccAddress
+}
+
+// NewMsgCollectorContext returns a child context of ctx whose module calls are collected by the
+// returned collector on behalf of the signers
+func NewMsgCollectorContext(ctx context.Context, signers ...AccAddress) (context.Context, *MsgCollector) {
+	collector := &MsgCollector{signers: signers}
+	return context.WithValue(ctx, msgCollectorKey{}, collector), collector
+}
+
+// MsgCollectorFromContext returns the collector of the module calls bound to ctx, if any
+func MsgCollectorFromContext(ctx context.Context) (*MsgCollector, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	collector, ok := ctx.Value(msgCollectorKey{}).(*MsgCollector)
+	return collector, ok
+}
+
+// IsMsgCollected reports whether the error is the ErrMsgCollected of a collected call
+func IsMsgCollected(err error) bool {
+	e, ok := err.(Error)
+	return ok && e.Codespace() == ErrMsgCollected.Codespace() && e.Code() == ErrMsgCollected.Code()
+}
+
+func (c *MsgCollector) Collect(msgs ...Msg) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.msgs = append(c.msgs, msgs...)
+}
+
+// Msgs returns the collected msgs in the order of the calls
+func (c *MsgCollector) Msgs() []Msg {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return append([]Msg(nil), c.msgs...)
+}
+
+// Signer returns the signer of the collector whose bech32 address is the name
+func (c *MsgCollector) Signer(name string) (AccAddress, bool) {
+	for _, signer := range c.signers {
+		if signer.String() == name {
+			return signer, true
+		}
+	}
+	return nil, false
+}
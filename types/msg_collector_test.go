@@ -0,0 +1,44 @@
+package types
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCollector(t *testing.T) {
+	_, ok := MsgCollectorFromContext(context.Background())
+	require.False(t, ok)
+
+	ctx, collector := NewMsgCollectorContext(context.Background())
+	child, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// the collector is inherited by the child contexts
+	c, ok := MsgCollectorFromContext(child)
+	require.True(t, ok)
+	c.Collect(&testMsg{id: 1})
+	c.Collect(&testMsg{id: 2}, &testMsg{id: 3})
+
+	msgs := collector.Msgs()
+	require.Len(t, msgs, 3)
+	for i, msg := range msgs {
+		require.Equal(t, i+1, msg.(*testMsg).id)
+	}
+
+	// the errors of the collected calls are wrapped on their way back
+	require.True(t, IsMsgCollected(Wrap(ErrMsgCollected)))
+	require.False(t, IsMsgCollected(Wrapf("msgs collected instead of broadcast")))
+}
+
+func TestMsgCollectorSigner(t *testing.T) {
+	granter := AccAddress(make([]byte, 20))
+	_, collector := NewMsgCollectorContext(context.Background(), granter)
+
+	signer, ok := collector.Signer(granter.String())
+	require.True(t, ok)
+	require.Equal(t, granter, signer)
+	_, ok = collector.Signer(AccAddress(make([]byte, 32)).String())
+	require.False(t, ok)
+}
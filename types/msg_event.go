@@ -0,0 +1,45 @@
+package types
+
+// EventDataMsg is a message of a tx delivered by a message subscription, along with its tx
+type EventDataMsg struct {
+	Hash   string `json:"hash"`
+	Height int64  `json:"height"`
+	// index of the tx in the block
+	Index uint32 `json:"index"`
+	// index of the message in the tx
+	MsgIndex int      `json:"msg_index"`
+	Msg      Msg      `json:"msg"`
+	Tx       Tx       `json:"tx"`
+	Result   TxResult `json:"result"`
+}
+
+type EventMsgHandler func(EventDataMsg)
+
+// NewMsgQueryBuilder returns a builder of the query of the txs with a message of the type,
+// such as MsgSend{}.Type()
+func NewMsgQueryBuilder(msgType string) *EventQueryBuilder {
+	return NewEventQueryBuilder().AddCondition(
+		NewCond(EventTypeMessage, AttributeKeyAction).EQ(EventValue(msgType)),
+	)
+}
+
+// SubscribeMsgs subscribes the txs matching the builder and calls the handler with every
+// message of the txs, the handler picks the messages it is interested in by their type
+func SubscribeMsgs(client WSClient, builder *EventQueryBuilder, handler EventMsgHandler) (Subscription, Error) {
+	return client.SubscribeTx(builder, func(tx EventDataTx) {
+		if tx.Tx == nil {
+			return
+		}
+		for i, msg := range tx.Tx.GetMsgs() {
+			handler(EventDataMsg{
+				Hash:     tx.Hash,
+				Height:   tx.Height,
+				Index:    tx.Index,
+				MsgIndex: i,
+				Msg:      msg,
+				Tx:       tx.Tx,
+				Result:   tx.Result,
+			})
+		}
+	})
+}
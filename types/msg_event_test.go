@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testWSClient struct {
+	WSClient
+	query   string
+	handler EventTxHandler
+}
+
+func (c *testWSClient) SubscribeTx(builder *EventQueryBuilder, handler EventTxHandler) (Subscription, Error) {
+	c.query = builder.Build()
+	c.handler = handler
+	return Subscription{Query: c.query}, nil
+}
+
+type testMsg struct {
+	Msg
+	id int
+}
+
+type testTx struct {
+	Tx
+	msgs []Msg
+}
+
+func (tx testTx) GetMsgs() []Msg { return tx.msgs }
+
+func TestSubscribeMsgs(t *testing.T) {
+	client := &testWSClient{}
+	var received []EventDataMsg
+	builder := NewMsgQueryBuilder("send").AddCondition(NewCond(EventTypeMessage, AttributeKeySender).EQ("a"))
+	subscription, err := SubscribeMsgs(client, builder, func(data EventDataMsg) {
+		received = append(received, data)
+	})
+	require.NoError(t, err)
+	require.Equal(t, "message.action='send' AND message.sender='a'", subscription.Query)
+
+	// the txs that could not be decoded are skipped
+	client.handler(EventDataTx{Hash: "A", Height: 1})
+	require.Empty(t, received)
+
+	client.handler(EventDataTx{Hash: "B", Height: 2, Index: 3, Tx: testTx{msgs: []Msg{&testMsg{id: 1}, &testMsg{id: 2}}}})
+	require.Len(t, received, 2)
+	for i, data := range received {
+		require.Equal(t, "B", data.Hash)
+		require.Equal(t, int64(2), data.Height)
+		require.Equal(t, uint32(3), data.Index)
+		require.Equal(t, i, data.MsgIndex)
+		require.Equal(t, i+1, data.Msg.(*testMsg).id)
+	}
+}
@@ -0,0 +1,105 @@
+package types
+
+// DefaultPageSize is the number of items fetched by each query of a PageIterator by default
+const DefaultPageSize = 100
+
+// PageOptions bounds the iteration of a paginated query
+type PageOptions struct {
+	// number of the items fetched by each query, DefaultPageSize if 0
+	PageSize uint64 `json:"page_size"`
+	// the iteration stops after the number of items, unlimited if 0
+	MaxItems uint64 `json:"max_items"`
+}
+
+// TxSearchOptions bounds the iteration of the txs matching a query
+type TxSearchOptions struct {
+	PageOptions
+	// iterates the txs from the latest one
+	Desc bool `json:"desc"`
+}
+
+// PageFetcher fetches the page starting at the key, the key of the first page being nil, with at
+// most limit items (a fetcher with a fixed page size may return more, the extra items are still
+// iterated). It returns the items and the key of the next page, which is empty after the last page.
+type PageFetcher func(key []byte, limit uint64) (items []interface{}, nextKey []byte, err error)
+
+// PageIterator iterates the items of a paginated query, fetching the pages one by one as they
+// are consumed:
+//
+//	it := sdk.NewPageIterator(fetch, sdk.PageOptions{MaxItems: 1000})
+//	for it.Next() {
+//		item := it.Item()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type PageIterator struct {
+	fetch PageFetcher
+	opts  PageOptions
+
+	key   []byte
+	items []interface{}
+	item  interface{}
+	count uint64
+	done  bool
+	err   Error
+}
+
+func NewPageIterator(fetch PageFetcher, opts PageOptions) *PageIterator {
+	if opts.PageSize == 0 {
+		opts.PageSize = DefaultPageSize
+	}
+	return &PageIterator{fetch: fetch, opts: opts}
+}
+
+// Next advances the iterator to the next item, fetching the next page when the current one is
+// consumed. It returns false when the items are exhausted, MaxItems is reached or a query failed.
+func (it *PageIterator) Next() bool {
+	it.item = nil
+	if it.err != nil || (it.opts.MaxItems > 0 && it.count >= it.opts.MaxItems) {
+		return false
+	}
+
+	for len(it.items) == 0 {
+		if it.done {
+			return false
+		}
+
+		limit := it.opts.PageSize
+		if it.opts.MaxItems > 0 && it.opts.MaxItems-it.count < limit {
+			limit = it.opts.MaxItems - it.count
+		}
+		items, nextKey, err := it.fetch(it.key, limit)
+		if err != nil {
+			it.err = Wrap(err)
+			return false
+		}
+		it.items = items
+		it.key = nextKey
+		it.done = len(nextKey) == 0
+	}
+
+	it.item = it.items[0]
+	it.items = it.items[1:]
+	it.count++
+	return true
+}
+
+// Item returns the current item, nil before the first call to Next or after the iteration ended
+func (it *PageIterator) Item() interface{} {
+	return it.item
+}
+
+// Err returns the error of the query which stopped the iteration, if any
+func (it *PageIterator) Err() Error {
+	return it.err
+}
+
+// Each calls the callback with the remaining items until it returns false, and returns Err
+func (it *PageIterator) Each(callback func(item interface{}) bool) Error {
+	for it.Next() {
+		if !callback(it.Item()) {
+			break
+		}
+	}
+	return it.Err()
+}
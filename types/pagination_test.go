@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testPages serves the numbers below total, the key being the first number of the page
+func testPages(total int, limits *[]uint64) PageFetcher {
+	return func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		*limits = append(*limits, limit)
+		start := 0
+		if key != nil {
+			start, _ = strconv.Atoi(string(key))
+		}
+		var items []interface{}
+		for i := start; i < total && uint64(len(items)) < limit; i++ {
+			items = append(items, i)
+		}
+		if next := start + len(items); next < total {
+			return items, []byte(strconv.Itoa(next)), nil
+		}
+		return items, nil, nil
+	}
+}
+
+func TestPageIterator(t *testing.T) {
+	var limits []uint64
+	it := NewPageIterator(testPages(7, &limits), PageOptions{PageSize: 3})
+	var items []int
+	for it.Next() {
+		items = append(items, it.Item().(int))
+	}
+	require.NoError(t, it.Err())
+	require.Nil(t, it.Item())
+	require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, items)
+	require.Equal(t, []uint64{3, 3, 3}, limits)
+
+	// the last page is only as large as the remaining items
+	limits, items = nil, nil
+	it = NewPageIterator(testPages(7, &limits), PageOptions{PageSize: 3, MaxItems: 5})
+	require.NoError(t, it.Each(func(item interface{}) bool {
+		items = append(items, item.(int))
+		return true
+	}))
+	require.Equal(t, []int{0, 1, 2, 3, 4}, items)
+	require.Equal(t, []uint64{3, 2}, limits)
+
+	// the callback stops the iteration
+	limits, items = nil, nil
+	it = NewPageIterator(testPages(7, &limits), PageOptions{})
+	require.NoError(t, it.Each(func(item interface{}) bool {
+		items = append(items, item.(int))
+		return len(items) < 2
+	}))
+	require.Equal(t, []int{0, 1}, items)
+	require.Equal(t, []uint64{DefaultPageSize}, limits)
+
+	// an empty page ends the iteration
+	require.False(t, NewPageIterator(testPages(0, &limits), PageOptions{}).Next())
+
+	calls := 0
+	it = NewPageIterator(func(key []byte, limit uint64) ([]interface{}, []byte, error) {
+		calls++
+		if key == nil {
+			return []interface{}{1}, []byte("next"), nil
+		}
+		return nil, nil, errors.New("unavailable")
+	}, PageOptions{})
+	require.True(t, it.Next())
+	require.False(t, it.Next())
+	require.EqualError(t, it.Err(), "unavailable")
+	require.False(t, it.Next())
+	require.Equal(t, 2, calls)
+}
@@ -6,6 +6,8 @@ import (
 	"fmt"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
+	"github.com/irisnet/irishub-sdk-go/codec/legacy"
+	"github.com/irisnet/irishub-sdk-go/types/tx/signing"
 )
 
 const (
@@ -47,16 +49,14 @@ func NewStdFee(gas uint64, amount ...Coin) StdFee {
 
 // Fee bytes for signing later
 func (fee StdFee) Bytes() []byte {
-	//if len(fee.Amount) == 0 {
-	//	fee.Amount = Coins{}
-	//}
-	//bz, err := NewCodec().MarshalJSON(fee)
-	//if err != nil {
-	//	panic(err)
-	//}
-	//return bz
-	//TODO
-	return nil
+	if len(fee.Amount) == 0 {
+		fee.Amount = NewCoins()
+	}
+	bz, err := legacy.Cdc.MarshalJSON(fee)
+	if err != nil {
+		panic(err)
+	}
+	return bz
 }
 
 // Standard Signature
@@ -79,17 +79,23 @@ type StdSignMsg struct {
 
 // get message bytes
 func (msg StdSignMsg) Bytes(cdc codec.Marshaler) []byte {
-	var msgsBytes []json.RawMessage
-	for _, msg := range msg.Msgs {
+	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, 0, msg.Fee, msg.Msgs, msg.Memo)
+}
+
+// StdSignBytes returns the bytes to sign for a transaction.
+func StdSignBytes(chainID string, accnum, sequence, timeout uint64, fee StdFee, msgs []Msg, memo string) []byte {
+	msgsBytes := make([]json.RawMessage, 0, len(msgs))
+	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
 	}
-	bz, err := json.Marshal(StdSignDoc{
-		AccountNumber: msg.AccountNumber,
-		ChainID:       msg.ChainID,
-		Fee:           json.RawMessage(msg.Fee.Bytes()),
-		Memo:          msg.Memo,
+	bz, err := legacy.Cdc.MarshalJSON(StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		Fee:           json.RawMessage(fee.Bytes()),
+		Memo:          memo,
 		Msgs:          msgsBytes,
-		Sequence:      msg.Sequence,
+		Sequence:      sequence,
+		TimeoutHeight: timeout,
 	})
 	if err != nil {
 		panic(err)
@@ -109,6 +115,7 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo"`
 	Msgs          []json.RawMessage `json:"msgs"`
 	Sequence      uint64            `json:"sequence"`
+	TimeoutHeight uint64            `json:"timeout_height,omitempty"`
 }
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
@@ -206,8 +213,34 @@ type BaseTx struct {
 	Memo          string        `json:"memo"`
 	Mode          BroadcastMode `json:"broadcast_mode"`
 	Simulate      bool          `json:"simulate"`
+	AutoGas       bool          `json:"auto_gas"`
+	GasPrices     DecCoins      `json:"gas_prices"`
+	MaxFee        DecCoins      `json:"max_fee"`
 	AccountNumber uint64        `json:"account_number"`
 	Sequence      uint64        `json:"sequence"`
+	// AccountNumberSet and SequenceSet mark AccountNumber and Sequence as given even if they are zero,
+	// the non-zero values are always given. The missing ones are queried from the chain.
+	AccountNumberSet bool `json:"account_number_set"`
+	SequenceSet      bool `json:"sequence_set"`
+	// SignMode overrides the sign mode of the ClientConfig for this transaction
+	SignMode signing.SignMode `json:"sign_mode"`
+	// FeeGranter is the bech32 address of the account whose fee allowance pays the fee
+	FeeGranter string `json:"fee_granter"`
+	// FeePayer is the name of the key of the account paying the fee, which signs the transaction
+	// after From. BuildUnsignedTx also accepts the bech32 address of a payer signing offline.
+	FeePayer         string `json:"fee_payer"`
+	FeePayerPassword string `json:"fee_payer_password"`
+}
+
+// HasAccountNumber returns whether the account number of the sender is given
+func (b BaseTx) HasAccountNumber() bool {
+	return b.AccountNumberSet || b.AccountNumber != 0
+}
+
+// HasSequence returns whether the sequence of the sender is given, the sequence manager
+// of the client is bypassed if it is
+func (b BaseTx) HasSequence() bool {
+	return b.SequenceSet || b.Sequence != 0
 }
 
 // ResultTx encapsulates the return result of the transaction. When the transaction fails,
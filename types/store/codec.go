@@ -1,6 +1,9 @@
 package store
 
 import (
+	"bytes"
+	"fmt"
+
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/irisnet/irishub-sdk-go/codec"
@@ -29,3 +32,13 @@ func PubKeyFromBytes(pubKeyBytes []byte) (pubKey crypto.PubKey, err error) {
 	err = cdc.UnmarshalBinaryBare(pubKeyBytes, &pubKey)
 	return
 }
+
+// checkPrivKey returns an error if the decrypted private key doesn't match the public key,
+// which means the private key was decrypted with a wrong password
+func checkPrivKey(info KeyInfo) error {
+	privKey, err := cryptoAmino.PrivKeyFromBytes([]byte(info.PrivKeyArmor))
+	if err != nil || !bytes.Equal(cryptoAmino.MarshalPubkey(privKey.PubKey()), info.PubKey) {
+		return fmt.Errorf("incorrect password of %s", info.Name)
+	}
+	return nil
+}
@@ -1,194 +1,546 @@
 package store
 
-//
-//import (
-//	"encoding/json"
-//	"fmt"
-//	"io/ioutil"
-//	"os"
-//	"path/filepath"
-//	"strings"
-//	"time"
-//
-//	"github.com/99designs/keyring"
-//	jose "github.com/dvsekhvalnov/jose2go"
-//	"github.com/mitchellh/go-homedir"
-//	"github.com/mtibben/percent"
-//	"github.com/pkg/errors"
-//
-//	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
-//	"github.com/irisnet/irishub-sdk-go/crypto/hd"
-//)
-//
-//const (
-//	keyringFileDirName = "keyring-file"
-//)
-//
-//var (
-//	_ KeyDAO = FileDAO{}
-//
-//	filenameEscape = func(s string) string {
-//		return percent.Encode(s, "/")
-//	}
-//)
-//
-////Execute the local file system to realize the persistence of the key data, and the stored data is encrypted using `PBES2`.
-////Can directly read the data of `iris` keys (--keyring-backend = file)
-//type FileDAO struct {
-//	dir string
-//}
-//
-//func NewFileDAO(dir string) KeyDAO {
-//	fileDir := filepath.Join(dir, keyringFileDirName)
-//	return &FileDAO{dir: fileDir}
-//}
-//
-//// Write will use user password to encrypt data and save to file, the file name is user name
-//func (f FileDAO) Write(name, password string, info KeyInfo) error {
-//	pubkey, err := PubKeyFromBytes(info.PubKey)
-//	if err != nil {
-//		return err
-//	}
-//
-//	lInfo := localInfo{
-//		Name:         info.Name,
-//		PubKey:       pubkey,
-//		PrivKeyArmor: info.PrivKeyArmor,
-//		Algo:         hd.PubKeyType(info.Algo),
-//	}
-//
-//	item := keyring.Item{
-//		Key:  name,
-//		Data: marshalInfo(lInfo),
-//	}
-//
-//	bytes, err := json.Marshal(item)
-//	if err != nil {
-//		return err
-//	}
-//
-//	token, err := jose.Encrypt(
-//		string(bytes), jose.PBES2_HS256_A128KW, jose.A256GCM, password,
-//		jose.Headers(map[string]interface{}{"created": time.Now().String()}),
-//	)
-//	if err != nil {
-//		return err
-//	}
-//
-//	filename, err := f.filename(item.Key)
-//	if err != nil {
-//		return err
-//	}
-//
-//	return ioutil.WriteFile(filename, []byte(token), 0600)
-//}
-//
-//// Read will read encrypted data from file and decrypt with user password
-//func (f FileDAO) Read(name, password string) (KeyInfo, error) {
-//	filename, err := f.filename(name)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	if len(password) == 0 {
-//		return KeyInfo{}, fmt.Errorf("no password")
-//	}
-//
-//	bytes, err := ioutil.ReadFile(filename)
-//	if err != nil {
-//		return KeyInfo{}, errors.Wrap(err, "not found")
-//	}
-//
-//	payload, _, err := jose.Decode(string(bytes), password)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	var decoded keyring.Item
-//	err = json.Unmarshal([]byte(payload), &decoded)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	info, err := unmarshalInfo(decoded.Data)
-//	if err != nil {
-//		return KeyInfo{}, err
-//	}
-//
-//	i, ok := info.(localInfo)
-//	if !ok {
-//		return KeyInfo{}, fmt.Errorf("only support type KeyInfo")
-//	}
-//
-//	return KeyInfo{
-//		Name:         i.Name,
-//		PubKey:       cryptoamino.MarshalPubkey(i.PubKey),
-//		PrivKeyArmor: i.PrivKeyArmor,
-//		Algo:         string(i.Algo),
-//	}, nil
-//}
-//
-//// Delete will delete user data and use user password to verify permissions
-//func (f FileDAO) Delete(name, password string) error {
-//	//Perform security verification
-//	if _, err := f.Read(name, password); err != nil {
-//		return err
-//	}
-//
-//	filename, err := f.filename(name)
-//	if err != nil {
-//		return err
-//	}
-//
-//	return os.Remove(filename)
-//}
-//
-//// Has returns whether the specified user name exists
-//func (f FileDAO) Has(name string) bool {
-//	filename, err := f.filename(name)
-//	if err != nil {
-//		return false
-//	}
-//	if _, err = os.Stat(filename); err == nil {
-//		return true
-//	}
-//	if os.IsNotExist(err) {
-//		return false
-//	}
-//	return false
-//}
-//
-//func (f *FileDAO) filename(key string) (string, error) {
-//	dir, err := f.resolveDir()
-//	if err != nil {
-//		return "", err
-//	}
-//
-//	return filepath.Join(dir, filenameEscape(string(infoKey(key)))), nil
-//}
-//
-//func (f *FileDAO) resolveDir() (string, error) {
-//	if f.dir == "" {
-//		return "", fmt.Errorf("no directory provided for file keyring")
-//	}
-//
-//	dir := f.dir
-//
-//	// expand tilde for home directory
-//	if strings.HasPrefix(dir, "~") {
-//		home, err := homedir.Dir()
-//		if err != nil {
-//			return "", err
-//		}
-//		dir = strings.Replace(dir, "~", home, 1)
-//	}
-//
-//	stat, err := os.Stat(dir)
-//	if os.IsNotExist(err) {
-//		err = os.MkdirAll(dir, 0700)
-//	} else if err != nil && !stat.IsDir() {
-//		err = fmt.Errorf("%s is a file, not a directory", dir)
-//	}
-//
-//	return dir, err
-//}
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	jose "github.com/dvsekhvalnov/jose2go"
+	"github.com/mitchellh/go-homedir"
+	"github.com/mtibben/percent"
+	"github.com/pkg/errors"
+	"github.com/tendermint/crypto/bcrypt"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+	"github.com/irisnet/irishub-sdk-go/crypto/hd"
+)
+
+const (
+	keyringFileDirName = "keyring-file"
+	keyHashFileName    = "keyhash"
+	addressSuffix      = "address"
+
+	// the headers of the encrypted items, "created" is also written by the `iris` CLI,
+	// the others allow listing the keys without the password
+	headerCreated = "created"
+	headerPubKey  = "pubkey"
+	headerAlgo    = "algo"
+
+	// the cost used by the `iris` CLI to hash the keyring passphrase
+	keyHashCost = 2
+
+	// the suffix of the files written by ChangePassword before they replace the keyring items
+	tmpSuffix = ".tmp"
+)
+
+var (
+	_ KeyDAO = FileDAO{}
+
+	filenameEscape = func(s string) string {
+		return percent.Encode(s, "/")
+	}
+	filenameUnescape = percent.Decode
+)
+
+// keyringItem is the json structure of an item of the `iris` file keyring,
+// it must be kept in line with the `Item` of github.com/99designs/keyring
+type keyringItem struct {
+	Key         string
+	Data        []byte
+	Label       string
+	Description string
+
+	KeychainNotTrustApplication bool
+	KeychainNotSynchronizable   bool
+}
+
+// FileDAO uses the local file system to realize the persistence of the key data, and the stored data is
+// encrypted using `PBES2`. It reads and writes the same data as `iris keys` (--keyring-backend = file),
+// so all keys of a directory must be protected by the same password, which is the keyring passphrase.
+type FileDAO struct {
+	dir        string
+	passphrase string
+}
+
+// NewFileDAO returns a FileDAO storing the keys in the `keyring-file` folder of dir,
+// dir is usually the home directory of the `iris` CLI, for example: ~/.iris
+func NewFileDAO(dir string) FileDAO {
+	return FileDAO{dir: filepath.Join(dir, keyringFileDirName)}
+}
+
+// WithPassphrase returns a copy of the FileDAO which lists the public key and algo of the keys
+// written by the `iris` CLI, by decrypting them with the keyring passphrase
+func (f FileDAO) WithPassphrase(passphrase string) FileDAO {
+	f.passphrase = passphrase
+	return f
+}
+
+// Write will use user password to encrypt data and save to file, the file name is user name
+func (f FileDAO) Write(name, password string, info KeyInfo) error {
+	if f.Has(name) {
+		return fmt.Errorf("name %s has exist", name)
+	}
+
+	if len(password) == 0 {
+		return fmt.Errorf("no password")
+	}
+
+	if err := f.checkPassword(password); err != nil {
+		return err
+	}
+
+	if info.CreatedAt.IsZero() {
+		info.CreatedAt = time.Now()
+	}
+	info.Name = name
+	return f.writeInfo(password, info)
+}
+
+// Read will read encrypted data from file and decrypt with user password
+func (f FileDAO) Read(name, password string) (KeyInfo, error) {
+	if len(password) == 0 {
+		return KeyInfo{}, fmt.Errorf("no password")
+	}
+
+	item, headers, err := f.readItem(string(infoKey(name)), password)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	info, err := unmarshalInfo(item.Data)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+
+	i, ok := info.(localInfo)
+	if !ok {
+		return KeyInfo{}, fmt.Errorf("only support type KeyInfo")
+	}
+
+	return KeyInfo{
+		Name:         i.Name,
+		PubKey:       cryptoamino.MarshalPubkey(i.PubKey),
+		PrivKeyArmor: i.PrivKeyArmor,
+		Algo:         string(i.Algo),
+		CreatedAt:    parseCreated(headers),
+	}, nil
+}
+
+// Delete will delete user data and use user password to verify permissions
+func (f FileDAO) Delete(name, password string) error {
+	//Perform security verification
+	info, err := f.Read(name, password)
+	if err != nil {
+		return err
+	}
+
+	pubkey, err := PubKeyFromBytes(info.PubKey)
+	if err != nil {
+		return err
+	}
+
+	filename, err := f.filename(addressKey(pubkey.Address()))
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	filename, err = f.filename(string(infoKey(name)))
+	if err != nil {
+		return err
+	}
+	return os.Remove(filename)
+}
+
+// Has returns whether the specified user name exists
+func (f FileDAO) Has(name string) bool {
+	filename, err := f.filename(string(infoKey(name)))
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(filename)
+	return err == nil
+}
+
+// List returns the metadata of all the keys. The `iris` CLI encrypts the public key and algo along
+// with the private key, so they are only available for its keys if the FileDAO has the passphrase.
+func (f FileDAO) List() ([]KeyMetadata, error) {
+	dir, err := f.resolveDir()
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []KeyMetadata
+	for _, file := range files {
+		key := filenameUnescape(file.Name())
+		if file.IsDir() || !strings.HasSuffix(key, "."+infoSuffix) {
+			continue
+		}
+
+		token, err := ioutil.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		headers, err := parseHeaders(string(token))
+		if err != nil {
+			return nil, err
+		}
+
+		metadata := KeyMetadata{
+			Name:      strings.TrimSuffix(key, "."+infoSuffix),
+			CreatedAt: parseCreated(headers),
+		}
+		if pubkey, ok := headers[headerPubKey].(string); ok {
+			if metadata.PubKey, err = hex.DecodeString(pubkey); err != nil {
+				return nil, err
+			}
+		}
+		if algo, ok := headers[headerAlgo].(string); ok {
+			metadata.Algo = algo
+		}
+		if metadata.PubKey == nil && len(f.passphrase) > 0 {
+			if metadata, err = f.decryptMetadata(key, metadata); err != nil {
+				return nil, err
+			}
+		}
+		keys = append(keys, metadata)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+	return keys, nil
+}
+
+// Rename changes the name of the key and use user password to verify permissions
+func (f FileDAO) Rename(name, newName, password string) error {
+	if f.Has(newName) {
+		return fmt.Errorf("name %s has exist", newName)
+	}
+
+	info, err := f.Read(name, password)
+	if err != nil {
+		return err
+	}
+
+	info.Name = newName
+	if err := f.writeInfo(password, info); err != nil {
+		return err
+	}
+
+	filename, err := f.filename(string(infoKey(name)))
+	if err != nil {
+		return err
+	}
+	return os.Remove(filename)
+}
+
+// ChangePassword changes the passphrase of the keyring. As all the keys of the `iris` file keyring
+// share the same passphrase, all of them are re-encrypted with the new password.
+func (f FileDAO) ChangePassword(name, oldPassword, newPassword string) error {
+	if !f.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+
+	if len(oldPassword) == 0 || len(newPassword) == 0 {
+		return fmt.Errorf("no password")
+	}
+
+	if err := f.checkPassword(oldPassword); err != nil {
+		return err
+	}
+
+	dir, err := f.resolveDir()
+	if err != nil {
+		return err
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	// decrypt all the items before writing anything, so that a wrong
+	// password doesn't leave the keyring with mixed passphrases
+	type decodedItem struct {
+		item    keyringItem
+		headers map[string]interface{}
+	}
+	var items []decodedItem
+	for _, file := range files {
+		if file.IsDir() || file.Name() == keyHashFileName || strings.HasSuffix(file.Name(), tmpSuffix) {
+			continue
+		}
+
+		item, headers, err := f.readItem(filenameUnescape(file.Name()), oldPassword)
+		if err != nil {
+			return err
+		}
+		items = append(items, decodedItem{item: item, headers: headers})
+	}
+
+	// write the re-encrypted items and the new passphrase hash aside, then move them into place,
+	// so that a failure doesn't leave the keyring with mixed passphrases
+	var tmpFiles []string
+	defer func() {
+		for _, tmp := range tmpFiles {
+			_ = os.Remove(tmp)
+		}
+	}()
+
+	for _, i := range items {
+		token, err := encryptItem(newPassword, i.item, i.headers)
+		if err != nil {
+			return err
+		}
+
+		filename, err := f.filename(i.item.Key)
+		if err != nil {
+			return err
+		}
+		tmpFiles = append(tmpFiles, filename+tmpSuffix)
+		if err := ioutil.WriteFile(filename+tmpSuffix, []byte(token), 0600); err != nil {
+			return err
+		}
+	}
+
+	keyHash, err := bcrypt.GenerateFromPassword(tmcrypto.CRandBytes(16), []byte(newPassword), keyHashCost)
+	if err != nil {
+		return err
+	}
+	keyHashFile := filepath.Join(dir, keyHashFileName)
+	tmpFiles = append(tmpFiles, keyHashFile+tmpSuffix)
+	if err := ioutil.WriteFile(keyHashFile+tmpSuffix, keyHash, 0600); err != nil {
+		return err
+	}
+
+	for _, tmp := range tmpFiles {
+		if err := os.Rename(tmp, strings.TrimSuffix(tmp, tmpSuffix)); err != nil {
+			return err
+		}
+	}
+	tmpFiles = nil
+	return nil
+}
+
+// decryptMetadata completes the metadata of a key written by the `iris` CLI with its public key and algo
+func (f FileDAO) decryptMetadata(key string, metadata KeyMetadata) (KeyMetadata, error) {
+	item, _, err := f.readItem(key, f.passphrase)
+	if err != nil {
+		return metadata, errors.Wrapf(err, "failed to decrypt %s", metadata.Name)
+	}
+
+	info, err := unmarshalInfo(item.Data)
+	if err != nil {
+		return metadata, err
+	}
+
+	metadata.PubKey = cryptoamino.MarshalPubkey(info.GetPubKey())
+	metadata.Algo = string(info.GetAlgo())
+	return metadata, nil
+}
+
+func (f FileDAO) writeInfo(password string, info KeyInfo) error {
+	pubkey, err := PubKeyFromBytes(info.PubKey)
+	if err != nil {
+		return err
+	}
+
+	lInfo := localInfo{
+		Name:         info.Name,
+		PubKey:       pubkey,
+		PrivKeyArmor: info.PrivKeyArmor,
+		Algo:         hd.PubKeyType(info.Algo),
+	}
+
+	headers := map[string]interface{}{
+		headerCreated: info.CreatedAt.String(),
+		headerPubKey:  hex.EncodeToString(info.PubKey),
+		headerAlgo:    info.Algo,
+	}
+
+	if err := f.writeItem(password, keyringItem{
+		Key:  string(infoKey(info.Name)),
+		Data: marshalInfo(lInfo),
+	}, headers); err != nil {
+		return err
+	}
+
+	// the address index allows the `iris` CLI to look up the key by its address
+	return f.writeItem(password, keyringItem{
+		Key:  addressKey(pubkey.Address()),
+		Data: infoKey(info.Name),
+	}, map[string]interface{}{headerCreated: info.CreatedAt.String()})
+}
+
+func (f FileDAO) writeItem(password string, item keyringItem, headers map[string]interface{}) error {
+	token, err := encryptItem(password, item, headers)
+	if err != nil {
+		return err
+	}
+
+	filename, err := f.filename(item.Key)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filename, []byte(token), 0600)
+}
+
+func (f FileDAO) readItem(key, password string) (keyringItem, map[string]interface{}, error) {
+	filename, err := f.filename(key)
+	if err != nil {
+		return keyringItem{}, nil, err
+	}
+
+	bytes, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return keyringItem{}, nil, errors.Wrap(err, "not found")
+	}
+
+	payload, headers, err := jose.Decode(string(bytes), password)
+	if err != nil {
+		return keyringItem{}, nil, err
+	}
+
+	var item keyringItem
+	if err = json.Unmarshal([]byte(payload), &item); err != nil {
+		return keyringItem{}, nil, err
+	}
+	return item, customHeaders(headers), nil
+}
+
+// checkPassword verifies the password against the passphrase hash of the keyring,
+// the hash is created on the first write as the `iris` CLI does.
+func (f FileDAO) checkPassword(password string) error {
+	dir, err := f.resolveDir()
+	if err != nil {
+		return err
+	}
+
+	filename := filepath.Join(dir, keyHashFileName)
+	keyHash, err := ioutil.ReadFile(filename)
+	switch {
+	case err == nil:
+		if err := bcrypt.CompareHashAndPassword(keyHash, []byte(password)); err != nil {
+			return fmt.Errorf("incorrect keyring passphrase")
+		}
+		return nil
+	case os.IsNotExist(err):
+		keyHash, err := bcrypt.GenerateFromPassword(tmcrypto.CRandBytes(16), []byte(password), keyHashCost)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filename, keyHash, 0600)
+	default:
+		return err
+	}
+}
+
+func (f FileDAO) filename(key string) (string, error) {
+	dir, err := f.resolveDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, filenameEscape(key)), nil
+}
+
+func (f FileDAO) resolveDir() (string, error) {
+	if f.dir == "" {
+		return "", fmt.Errorf("no directory provided for file keyring")
+	}
+
+	dir := f.dir
+
+	// expand tilde for home directory
+	if strings.HasPrefix(dir, "~") {
+		home, err := homedir.Dir()
+		if err != nil {
+			return "", err
+		}
+		dir = strings.Replace(dir, "~", home, 1)
+	}
+
+	stat, err := os.Stat(dir)
+	if os.IsNotExist(err) {
+		err = os.MkdirAll(dir, 0700)
+	} else if err == nil && !stat.IsDir() {
+		err = fmt.Errorf("%s is a file, not a directory", dir)
+	}
+
+	return dir, err
+}
+
+// encryptItem encrypts the item into a JWE token as the `iris` file keyring does
+func encryptItem(password string, item keyringItem, headers map[string]interface{}) (string, error) {
+	bytes, err := json.Marshal(item)
+	if err != nil {
+		return "", err
+	}
+
+	return jose.Encrypt(
+		string(bytes), jose.PBES2_HS256_A128KW, jose.A256GCM, password,
+		jose.Headers(customHeaders(headers)),
+	)
+}
+
+func addressKey(address []byte) string {
+	return fmt.Sprintf("%s.%s", hex.EncodeToString(address), addressSuffix)
+}
+
+// customHeaders filters out the headers generated by jose
+func customHeaders(headers map[string]interface{}) map[string]interface{} {
+	custom := make(map[string]interface{})
+	for _, k := range []string{headerCreated, headerPubKey, headerAlgo} {
+		if v, ok := headers[k]; ok {
+			custom[k] = v
+		}
+	}
+	if _, ok := custom[headerCreated]; !ok {
+		custom[headerCreated] = time.Now().String()
+	}
+	return custom
+}
+
+// parseHeaders decodes the headers of a JWE token without decrypting it
+func parseHeaders(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	bz, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var headers map[string]interface{}
+	if err := json.Unmarshal(bz, &headers); err != nil {
+		return nil, err
+	}
+	return headers, nil
+}
+
+// parseCreated parses the "created" header, which is written in the format of time.Time.String()
+func parseCreated(headers map[string]interface{}) time.Time {
+	created, _ := headers[headerCreated].(string)
+	// strip the monotonic clock reading
+	if i := strings.Index(created, " m="); i >= 0 {
+		created = created[:i]
+	}
+	t, _ := time.Parse("2006-01-02 15:04:05.999999999 -0700 MST", created)
+	return t
+}
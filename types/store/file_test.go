@@ -0,0 +1,133 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+)
+
+func TestFileDAO(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	km, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+	_, priv := km.Generate()
+
+	name, password := "test/key", "12345678"
+	info := KeyInfo{
+		Name:         name,
+		PubKey:       cryptoamino.MarshalPubkey(km.ExportPubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
+		Algo:         "secp256k1",
+	}
+
+	dao := NewFileDAO(dir)
+	require.False(t, dao.Has(name))
+	require.NoError(t, dao.Write(name, password, info))
+	require.True(t, dao.Has(name))
+	require.Error(t, dao.Write(name, password, info))
+
+	// the file names are percent-escaped and only readable by the owner
+	stat, err := os.Stat(filepath.Join(dir, keyringFileDirName, "test%2Fkey.info"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), stat.Mode().Perm())
+
+	got, err := dao.Read(name, password)
+	require.NoError(t, err)
+	require.False(t, got.CreatedAt.IsZero())
+	info.CreatedAt = got.CreatedAt
+	require.Equal(t, info, got)
+
+	_, err = dao.Read(name, "wrong password")
+	require.Error(t, err)
+
+	// every key of the keyring shares the same passphrase
+	require.Error(t, dao.Write("other", "wrong password", info))
+
+	// the metadata is readable without the password
+	keys, err := dao.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, info.Metadata(), keys[0])
+
+	newName, newPassword := "renamed", "87654321"
+	require.Error(t, dao.Rename(name, newName, "wrong password"))
+	require.NoError(t, dao.Rename(name, newName, password))
+	require.False(t, dao.Has(name))
+	require.True(t, dao.Has(newName))
+
+	require.Error(t, dao.ChangePassword(newName, "wrong password", newPassword))
+	require.NoError(t, dao.ChangePassword(newName, password, newPassword))
+	_, err = dao.Read(newName, password)
+	require.Error(t, err)
+	got, err = dao.Read(newName, newPassword)
+	require.NoError(t, err)
+	require.Equal(t, newName, got.Name)
+	require.Equal(t, info.PrivKeyArmor, got.PrivKeyArmor)
+	require.True(t, info.CreatedAt.Equal(got.CreatedAt))
+	name, password = newName, newPassword
+
+	require.Error(t, dao.Delete(name, "wrong password"))
+	require.NoError(t, dao.Delete(name, password))
+	require.False(t, dao.Has(name))
+
+	files, err := ioutil.ReadDir(filepath.Join(dir, keyringFileDirName))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.Equal(t, keyHashFileName, files[0].Name())
+}
+
+func TestFileDAOListCLIKeys(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyring")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	km, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+	_, priv := km.Generate()
+
+	// the `iris` CLI only writes the "created" header, the public key is encrypted in the record
+	name, password := "cli", "12345678"
+	dao := NewFileDAO(dir)
+	require.NoError(t, dao.checkPassword(password))
+	require.NoError(t, dao.writeItem(password, keyringItem{
+		Key: string(infoKey(name)),
+		Data: marshalInfo(localInfo{
+			Name:         name,
+			PubKey:       km.ExportPubKey(),
+			PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
+			Algo:         "secp256k1",
+		}),
+	}, nil))
+
+	keys, err := dao.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, name, keys[0].Name)
+	require.Nil(t, keys[0].PubKey)
+
+	_, err = dao.WithPassphrase("wrong password").List()
+	require.Error(t, err)
+
+	keys, err = dao.WithPassphrase(password).List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	require.Equal(t, cryptoamino.MarshalPubkey(km.ExportPubKey()), keys[0].PubKey)
+	require.Equal(t, "secp256k1", keys[0].Algo)
+
+	// changing the passphrase leaves no temporary file behind
+	require.NoError(t, dao.ChangePassword(name, password, "87654321"))
+	files, err := ioutil.ReadDir(filepath.Join(dir, keyringFileDirName))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	_, err = dao.WithPassphrase("87654321").List()
+	require.NoError(t, err)
+}
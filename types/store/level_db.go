@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	dbm "github.com/tendermint/tm-db"
 )
@@ -53,6 +56,9 @@ func (k LevelDBDAO) Write(name, password string, info KeyInfo) error {
 	}
 
 	info.PrivKeyArmor = privStr
+	if info.CreatedAt.IsZero() {
+		info.CreatedAt = time.Now()
+	}
 
 	bz, err := json.Marshal(info)
 	if err != nil {
@@ -113,6 +119,105 @@ func (k LevelDBDAO) Has(name string) bool {
 	return existed
 }
 
+// List returns the metadata of all the keys in the local store
+func (k LevelDBDAO) List() ([]KeyMetadata, error) {
+	itr, err := k.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	var keys []KeyMetadata
+	for ; itr.Valid(); itr.Next() {
+		if !strings.HasSuffix(string(itr.Key()), "."+infoSuffix) {
+			continue
+		}
+
+		var info KeyInfo
+		if err := json.Unmarshal(itr.Value(), &info); err != nil {
+			return nil, err
+		}
+		keys = append(keys, info.Metadata())
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+	return keys, itr.Error()
+}
+
+// Rename changes the name of a key in the local store
+func (k LevelDBDAO) Rename(name, newName, password string) error {
+	if !k.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+
+	if k.Has(newName) {
+		return fmt.Errorf("name %s has exist", newName)
+	}
+
+	//Perform security verification
+	info, err := k.Read(name, password)
+	if err != nil {
+		return err
+	}
+	if err := checkPrivKey(info); err != nil {
+		return err
+	}
+
+	info, err = k.ReadMetadata(name)
+	if err != nil {
+		return err
+	}
+	info.Name = newName
+
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	batch := k.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(infoKey(newName), bz); err != nil {
+		return err
+	}
+	if err := batch.Delete(infoKey(name)); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// ChangePassword re-encrypts the private key with the new password
+func (k LevelDBDAO) ChangePassword(name, oldPassword, newPassword string) error {
+	if !k.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+
+	if len(oldPassword) == 0 || len(newPassword) == 0 {
+		return fmt.Errorf("no password")
+	}
+
+	info, err := k.Read(name, oldPassword)
+	if err != nil {
+		return err
+	}
+	if err := checkPrivKey(info); err != nil {
+		return err
+	}
+
+	privStr, err := k.Encrypt(info.PrivKeyArmor, newPassword)
+	if err != nil {
+		return err
+	}
+	info.PrivKeyArmor = privStr
+
+	bz, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return k.db.SetSync(infoKey(name), bz)
+}
+
 func infoKey(name string) []byte {
 	return []byte(fmt.Sprintf("%s.%s", name, infoSuffix))
 }
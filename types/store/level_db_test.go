@@ -0,0 +1,57 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+)
+
+func TestLevelDBDAO(t *testing.T) {
+	dir, err := ioutil.TempDir("", "leveldb")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	dao, err := NewLevelDB(dir, nil)
+	require.NoError(t, err)
+
+	var infos []KeyInfo
+	for _, name := range []string{"b", "a"} {
+		km, err := crypto.NewAlgoKeyManager("secp256k1")
+		require.NoError(t, err)
+		_, priv := km.Generate()
+
+		info := KeyInfo{
+			Name:         name,
+			PubKey:       cryptoamino.MarshalPubkey(km.ExportPubKey()),
+			PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
+			Algo:         "secp256k1",
+		}
+		require.NoError(t, dao.Write(name, "12345678", info))
+		infos = append(infos, info)
+	}
+
+	keys, err := dao.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	require.Equal(t, "a", keys[0].Name)
+	require.Equal(t, infos[1].PubKey, keys[0].PubKey)
+	require.Equal(t, "b", keys[1].Name)
+	require.False(t, keys[1].CreatedAt.IsZero())
+
+	require.Error(t, dao.Rename("a", "b", "12345678"))
+	require.Error(t, dao.Rename("a", "c", "wrong password"))
+	require.NoError(t, dao.Rename("a", "c", "12345678"))
+	require.False(t, dao.Has("a"))
+
+	require.Error(t, dao.ChangePassword("c", "wrong password", "87654321"))
+	require.NoError(t, dao.ChangePassword("c", "12345678", "87654321"))
+	info, err := dao.Read("c", "87654321")
+	require.NoError(t, err)
+	require.Equal(t, "c", info.Name)
+	require.Equal(t, infos[1].PrivKeyArmor, info.PrivKeyArmor)
+}
@@ -1,6 +1,13 @@
 package store
 
-// Use memory as storage, use with caution in build environment
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Use memory as storage, use with caution in build environment.
+// The private keys are encrypted with the password as LevelDBDAO does.
 type MemoryDAO struct {
 	store map[string]KeyInfo
 	Crypto
@@ -15,13 +22,38 @@ func NewMemory(crypto Crypto) MemoryDAO {
 		Crypto: crypto,
 	}
 }
+
 func (m MemoryDAO) Write(name, password string, store KeyInfo) error {
+	privStr, err := m.Encrypt(store.PrivKeyArmor, password)
+	if err != nil {
+		return err
+	}
+
+	store.PrivKeyArmor = privStr
+	if store.CreatedAt.IsZero() {
+		store.CreatedAt = time.Now()
+	}
 	m.store[name] = store
 	return nil
 }
 
+// Read returns the key with its private key decrypted with the password, the stored private key
+// can't be used without it so a password is required. Use ReadMetadata to read the public part only.
 func (m MemoryDAO) Read(name, password string) (KeyInfo, error) {
-	return m.store[name], nil
+	store, ok := m.store[name]
+	if !ok {
+		return KeyInfo{}, fmt.Errorf("name %s not exist", name)
+	}
+	if len(password) == 0 {
+		return KeyInfo{}, fmt.Errorf("no password")
+	}
+
+	privStr, err := m.Decrypt(store.PrivKeyArmor, password)
+	if err != nil {
+		return KeyInfo{}, err
+	}
+	store.PrivKeyArmor = privStr
+	return store, nil
 }
 
 // ReadMetadata read a key information from the local store
@@ -38,3 +70,65 @@ func (m MemoryDAO) Has(name string) bool {
 	_, ok := m.store[name]
 	return ok
 }
+
+func (m MemoryDAO) List() ([]KeyMetadata, error) {
+	keys := make([]KeyMetadata, 0, len(m.store))
+	for _, info := range m.store {
+		keys = append(keys, info.Metadata())
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+	return keys, nil
+}
+
+func (m MemoryDAO) Rename(name, newName, password string) error {
+	if !m.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+	if m.Has(newName) {
+		return fmt.Errorf("name %s has exist", newName)
+	}
+
+	//Perform security verification
+	info, err := m.Read(name, password)
+	if err != nil {
+		return err
+	}
+	if err := checkPrivKey(info); err != nil {
+		return err
+	}
+
+	info = m.store[name]
+	info.Name = newName
+	m.store[newName] = info
+	delete(m.store, name)
+	return nil
+}
+
+// ChangePassword re-encrypts the private key with the new password
+func (m MemoryDAO) ChangePassword(name, oldPassword, newPassword string) error {
+	if !m.Has(name) {
+		return fmt.Errorf("name %s not exist", name)
+	}
+
+	if len(oldPassword) == 0 || len(newPassword) == 0 {
+		return fmt.Errorf("no password")
+	}
+
+	info, err := m.Read(name, oldPassword)
+	if err != nil {
+		return err
+	}
+	if err := checkPrivKey(info); err != nil {
+		return err
+	}
+
+	privStr, err := m.Encrypt(info.PrivKeyArmor, newPassword)
+	if err != nil {
+		return err
+	}
+	info.PrivKeyArmor = privStr
+	m.store[name] = info
+	return nil
+}
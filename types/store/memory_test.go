@@ -0,0 +1,46 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/irisnet/irishub-sdk-go/crypto"
+	cryptoamino "github.com/irisnet/irishub-sdk-go/crypto/codec"
+)
+
+func TestMemoryDAO(t *testing.T) {
+	dao := NewMemory(nil)
+
+	km, err := crypto.NewAlgoKeyManager("secp256k1")
+	require.NoError(t, err)
+	_, priv := km.Generate()
+
+	info := KeyInfo{
+		Name:         "a",
+		PubKey:       cryptoamino.MarshalPubkey(km.ExportPubKey()),
+		PrivKeyArmor: string(cryptoamino.MarshalPrivKey(priv)),
+		Algo:         "secp256k1",
+	}
+	require.NoError(t, dao.Write("a", "12345678", info))
+	require.NoError(t, dao.Write("b", "12345678", info))
+
+	// the encrypted private key is never returned
+	_, err = dao.Read("a", "")
+	require.Error(t, err)
+	_, err = dao.Read("unknown", "12345678")
+	require.Error(t, err)
+
+	require.Error(t, dao.Rename("a", "b", "12345678"))
+	require.Error(t, dao.Rename("a", "c", "wrong password"))
+	require.True(t, dao.Has("a"))
+	require.NoError(t, dao.Rename("a", "c", "12345678"))
+	require.False(t, dao.Has("a"))
+
+	require.Error(t, dao.ChangePassword("c", "wrong password", "87654321"))
+	require.NoError(t, dao.ChangePassword("c", "12345678", "87654321"))
+	got, err := dao.Read("c", "87654321")
+	require.NoError(t, err)
+	require.Equal(t, "c", got.Name)
+	require.Equal(t, info.PrivKeyArmor, got.PrivKeyArmor)
+}
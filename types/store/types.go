@@ -2,6 +2,7 @@ package store
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -22,10 +23,19 @@ const (
 
 // KeyInfo saves the basic information of the key
 type KeyInfo struct {
-	Name         string `json:"name"`
-	PubKey       []byte `json:"pubkey"`
-	PrivKeyArmor string `json:"priv_key_armor"`
-	Algo         string `json:"algo"`
+	Name         string    `json:"name"`
+	PubKey       []byte    `json:"pubkey"`
+	PrivKeyArmor string    `json:"priv_key_armor"`
+	Algo         string    `json:"algo"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// KeyMetadata is the public information of the key, which can be read without the password
+type KeyMetadata struct {
+	Name      string    `json:"name"`
+	PubKey    []byte    `json:"pubkey"`
+	Algo      string    `json:"algo"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type KeyDAO interface {
@@ -40,6 +50,15 @@ type KeyDAO interface {
 
 	// Has returns whether the specified user name exists
 	Has(name string) bool
+
+	// List returns the metadata of all the keys sorted by name, no password is required
+	List() ([]KeyMetadata, error)
+
+	// Rename changes the name of the key and use user password to verify permissions
+	Rename(name, newName, password string) error
+
+	// ChangePassword re-encrypts the key data with the new password
+	ChangePassword(name, oldPassword, newPassword string) error
 }
 
 type Crypto interface {
@@ -105,3 +124,13 @@ func unmarshalInfo(bz []byte) (info Info, err error) {
 	err = cdc.UnmarshalBinaryLengthPrefixed(bz, &info)
 	return
 }
+
+// Metadata returns the public information of the key
+func (info KeyInfo) Metadata() KeyMetadata {
+	return KeyMetadata{
+		Name:      info.Name,
+		PubKey:    info.PubKey,
+		Algo:      info.Algo,
+		CreatedAt: info.CreatedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package tx
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+	signingtypes "github.com/irisnet/irishub-sdk-go/types/tx/signing"
+)
+
+const aminoNonCriticalFieldsError = "protobuf transaction contains unknown non-critical fields. This is a transaction malleability issue and SIGN_MODE_LEGACY_AMINO_JSON cannot be used."
+
+// signModeLegacyAminoJSONHandler defines the SIGN_MODE_LEGACY_AMINO_JSON SignModeHandler
+type signModeLegacyAminoJSONHandler struct{}
+
+var _ sdk.SignModeHandler = signModeLegacyAminoJSONHandler{}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (s signModeLegacyAminoJSONHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+}
+
+// Modes implements SignModeHandler.Modes
+func (s signModeLegacyAminoJSONHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes
+func (s signModeLegacyAminoJSONHandler) GetSignBytes(mode signingtypes.SignMode, data sdk.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	if protoTx.txBodyHasUnknownNonCriticals {
+		return nil, fmt.Errorf(aminoNonCriticalFieldsError)
+	}
+
+	body := protoTx.tx.Body
+
+	if len(body.ExtensionOptions) != 0 || len(body.NonCriticalExtensionOptions) != 0 {
+		return nil, fmt.Errorf("%s does not support protobuf extension options", signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if err := checkAminoJSONMsg(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	return sdk.StdSignBytes(
+		data.ChainID, data.AccountNumber, data.Sequence, protoTx.GetTimeoutHeight(),
+		sdk.StdFee{Amount: protoTx.GetFee(), Gas: protoTx.GetGas()},
+		tx.GetMsgs(), protoTx.GetMemo(),
+	), nil
+}
+
+// checkAminoJSONMsg ensures the sign bytes of the msg are wrapped in the {"type":...,"value":...}
+// envelope of its amino name, the sign bytes of a msg missing from the amino codec of its module
+// are not the canonical ones verified by the chain.
+func checkAminoJSONMsg(msg sdk.Msg) error {
+	var envelope struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(msg.GetSignBytes(), &envelope); err != nil || envelope.Type == "" || envelope.Value == nil {
+		return fmt.Errorf("%T is not registered on the amino codec, %s is not supported", msg, signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	}
+	return nil
+}
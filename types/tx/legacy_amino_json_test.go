@@ -0,0 +1,25 @@
+package tx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/irisnet/irishub-sdk-go/types"
+)
+
+type signBytesMsg struct {
+	sdk.Msg
+	signBytes string
+}
+
+func (m signBytesMsg) GetSignBytes() []byte { return []byte(m.signBytes) }
+
+func TestCheckAminoJSONMsg(t *testing.T) {
+	require.NoError(t, checkAminoJSONMsg(signBytesMsg{signBytes: `{"type":"cosmos-sdk/MsgSend","value":{"amount":[]}}`}))
+
+	// the msgs missing from the amino codec are marshaled without their envelope
+	require.Error(t, checkAminoJSONMsg(signBytesMsg{signBytes: `{"amount":[],"from_address":"iaa1"}`}))
+	require.Error(t, checkAminoJSONMsg(signBytesMsg{signBytes: `{"type":"cosmos-sdk/MsgSend"}`}))
+	require.Error(t, checkAminoJSONMsg(signBytesMsg{signBytes: `[]`}))
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	"github.com/tendermint/tendermint/crypto"
+
+	codectypes "github.com/irisnet/irishub-sdk-go/codec/types"
 )
 
 // SignatureV2 is a convenience type that is easier to use in application logic
@@ -98,3 +100,20 @@ func SignatureDataFromProto(descData *SignatureDescriptor_Data) SignatureData {
 		panic(fmt.Errorf("unexpected case %+v", descData))
 	}
 }
+
+var _, _ codectypes.UnpackInterfacesMessage = &SignatureDescriptors{}, &SignatureDescriptor{}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (sds *SignatureDescriptors) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, sig := range sds.Signatures {
+		if err := sig.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfaceMessages.UnpackInterfaces method
+func (sd *SignatureDescriptor) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(sd.PublicKey, new(crypto.PubKey))
+}
@@ -85,3 +85,8 @@ type TxDecoder func(txBytes []byte) (Tx, error)
 
 // TxEncoder marshals transaction to bytes
 type TxEncoder func(tx Tx) ([]byte, error)
+
+// MsgTypeURL returns the type url of the msg, e.g. /cosmos.bank.v1beta1.MsgSend
+func MsgTypeURL(msg proto.Message) string {
+	return "/" + proto.MessageName(msg)
+}
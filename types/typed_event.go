@@ -0,0 +1,308 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	eventTag = "event"
+	// the tag option of the attributes whose absence is an error, e.g. `event:"proposal_id,required"`
+	requiredOption = "required"
+)
+
+var (
+	coinType     = reflect.TypeOf(Coin{})
+	coinsType    = reflect.TypeOf(Coins{})
+	decCoinsType = reflect.TypeOf(DecCoins{})
+	intType      = reflect.TypeOf(Int{})
+	decType      = reflect.TypeOf(Dec{})
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// TypedEvent is an event of a module decoded into a struct. The fields of the struct are
+// decoded from the attributes named by their `event` tag, the supported field types are
+// string, bool, the integer types, Int, Dec, Coin, Coins, DecCoins and time.Time. A missing attribute
+// leaves its field zero, unless the tag has the `required` option.
+type TypedEvent interface {
+	EventType() string
+}
+
+// EventRegistrar is implemented by the module clients that have typed events
+type EventRegistrar interface {
+	RegisterEvents(registry *EventRegistry)
+}
+
+// RawEvent is an event whose type has no registered typed event
+type RawEvent StringEvent
+
+func (e RawEvent) EventType() string { return e.Type }
+
+// MessageEvent is emitted by every message of a tx
+type MessageEvent struct {
+	Action string `event:"action"`
+	Module string `event:"module"`
+	Sender string `event:"sender"`
+}
+
+func (MessageEvent) EventType() string { return EventTypeMessage }
+
+// EventRegistry maps the event types to the typed events they are decoded into
+type EventRegistry struct {
+	mu    sync.RWMutex
+	types map[string]reflect.Type
+}
+
+// NewEventRegistry returns a registry of the message events
+func NewEventRegistry() *EventRegistry {
+	r := &EventRegistry{types: make(map[string]reflect.Type)}
+	r.RegisterEvents(MessageEvent{})
+	return r
+}
+
+// RegisterEvents registers the struct types of the events, registering another struct type
+// for a registered event type panics
+func (r *EventRegistry) RegisterEvents(events ...TypedEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, event := range events {
+		typ := reflect.TypeOf(event)
+		if typ.Kind() == reflect.Ptr {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			panic(fmt.Sprintf("typed event %s is not a struct", typ))
+		}
+		if registered, ok := r.types[event.EventType()]; ok && registered != typ {
+			panic(fmt.Sprintf("event type %s is already registered by %s", event.EventType(), registered))
+		}
+		r.types[event.EventType()] = typ
+	}
+}
+
+// ParseEvents decodes the events into the registered typed events, as pointers to the struct types,
+// the events of the other types are returned as RawEvent. The flattened events of a type are split
+// into one typed event per occurrence.
+func (r *EventRegistry) ParseEvents(events StringEvents) ([]TypedEvent, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var res []TypedEvent
+	for _, e := range events {
+		typ, ok := r.types[e.Type]
+		for _, attrs := range splitAttributes(e.Attributes) {
+			if !ok {
+				res = append(res, RawEvent{Type: e.Type, Attributes: attrs})
+				continue
+			}
+
+			ptr := reflect.New(typ)
+			if err := decodeAttributes(attrs, ptr.Elem()); err != nil {
+				return nil, fmt.Errorf("decode event %s: %w", e.Type, err)
+			}
+			res = append(res, ptr.Interface().(TypedEvent))
+		}
+	}
+	return res, nil
+}
+
+// ParseTxResult decodes the events of a tx result
+func (r *EventRegistry) ParseTxResult(result TxResult) ([]TypedEvent, error) {
+	return r.ParseEvents(result.Events)
+}
+
+// ParseBeginBlock decodes the events of the begin block
+func (r *EventRegistry) ParseBeginBlock(result ResultBeginBlock) ([]TypedEvent, error) {
+	return r.ParseEvents(result.Events)
+}
+
+// ParseEndBlock decodes the events of the end block
+func (r *EventRegistry) ParseEndBlock(result ResultEndBlock) ([]TypedEvent, error) {
+	return r.ParseEvents(result.Events)
+}
+
+// DecodeEvent decodes the first event of the type of target, which must be a pointer to a typed event
+func DecodeEvent(events StringEvents, target TypedEvent) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("target of the event %s must be a pointer to a struct", target.EventType())
+	}
+
+	for _, e := range events {
+		if e.Type == target.EventType() {
+			if err := decodeAttributes(splitAttributes(e.Attributes)[0], v.Elem()); err != nil {
+				return fmt.Errorf("decode event %s: %w", e.Type, err)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("not found event %s", target.EventType())
+}
+
+// DecodeEvents decodes every event of the type of the typed events in target, which must be
+// a pointer to a slice of typed event structs
+func DecodeEvents(events StringEvents, target interface{}) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice || v.Elem().Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("target of the events must be a pointer to a slice of structs")
+	}
+
+	slice := v.Elem()
+	elemType := slice.Type().Elem()
+	event, ok := reflect.Zero(elemType).Interface().(TypedEvent)
+	if !ok {
+		return fmt.Errorf("%s is not a typed event", elemType)
+	}
+
+	for _, e := range events {
+		if e.Type != event.EventType() {
+			continue
+		}
+		for _, attrs := range splitAttributes(e.Attributes) {
+			elem := reflect.New(elemType).Elem()
+			if err := decodeAttributes(attrs, elem); err != nil {
+				return fmt.Errorf("decode event %s: %w", e.Type, err)
+			}
+			slice = reflect.Append(slice, elem)
+		}
+	}
+	v.Elem().Set(slice)
+	return nil
+}
+
+// splitAttributes splits the attributes of the events of a type flattened into a single event,
+// an occurrence of the event ends before the first repeated attribute key
+func splitAttributes(attrs []Attribute) [][]Attribute {
+	var (
+		res   [][]Attribute
+		start int
+		seen  = make(map[string]bool)
+	)
+	for i, attr := range attrs {
+		if seen[attr.Key] {
+			res = append(res, attrs[start:i])
+			start = i
+			seen = make(map[string]bool)
+		}
+		seen[attr.Key] = true
+	}
+	return append(res, attrs[start:])
+}
+
+func decodeAttributes(attrs []Attribute, v reflect.Value) error {
+	typ := v.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		key, opts := parseEventTag(typ.Field(i).Tag.Get(eventTag))
+		if len(key) == 0 {
+			continue
+		}
+
+		found := false
+		for _, attr := range attrs {
+			if attr.Key != key {
+				continue
+			}
+			if err := decodeValue(attr.Value, v.Field(i)); err != nil {
+				return fmt.Errorf("attribute %s: %w", key, err)
+			}
+			found = len(attr.Value) > 0
+			break
+		}
+		if !found && opts[requiredOption] {
+			return fmt.Errorf("missing attribute %s", key)
+		}
+	}
+	return nil
+}
+
+// parseEventTag returns the attribute key and the options of an `event` tag
+func parseEventTag(tag string) (string, map[string]bool) {
+	parts := strings.Split(tag, ",")
+	opts := make(map[string]bool, len(parts)-1)
+	for _, opt := range parts[1:] {
+		opts[strings.TrimSpace(opt)] = true
+	}
+	return parts[0], opts
+}
+
+func decodeValue(value string, field reflect.Value) error {
+	if len(value) == 0 {
+		return nil
+	}
+
+	switch field.Type() {
+	case coinType:
+		coin, err := ParseCoin(value)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(coin))
+		return nil
+	case coinsType:
+		coins, err := ParseCoins(value)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(coins))
+		return nil
+	case decCoinsType:
+		coins, err := ParseDecCoins(value)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(coins))
+		return nil
+	case intType:
+		i, ok := NewIntFromString(value)
+		if !ok {
+			return fmt.Errorf("invalid integer %s", value)
+		}
+		field.Set(reflect.ValueOf(i))
+		return nil
+	case decType:
+		d, err := NewDecFromStr(value)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(d))
+		return nil
+	case timeType:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(value, 10, field.Type().Bits())
+		if err != nil {
+			return err
+		}
+		field.SetUint(u)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
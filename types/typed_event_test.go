@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testTransferEvent struct {
+	Recipient string `event:"recipient"`
+	Amount    Coins  `event:"amount"`
+}
+
+func (testTransferEvent) EventType() string { return "transfer" }
+
+type testUnbondEvent struct {
+	Amount         Int       `event:"amount"`
+	Height         int64     `event:"height"`
+	Jailed         bool      `event:"jailed"`
+	CompletionTime time.Time `event:"completion_time"`
+}
+
+func (testUnbondEvent) EventType() string { return "unbond" }
+
+type testProposalEvent struct {
+	ProposalID uint64 `event:"proposal_id,required"`
+	Type       string `event:"proposal_type"`
+}
+
+func (testProposalEvent) EventType() string { return "submit_proposal" }
+
+func TestEventRegistry(t *testing.T) {
+	registry := NewEventRegistry()
+	registry.RegisterEvents(testTransferEvent{}, &testUnbondEvent{})
+	require.Panics(t, func() {
+		registry.RegisterEvents(MessageEvent{}, &struct{ testTransferEvent }{})
+	})
+
+	// the transfers are flattened into a single event
+	events := StringEvents{
+		{Type: "message", Attributes: []Attribute{{"action", "send"}, {"sender", "a"}}},
+		{Type: "transfer", Attributes: []Attribute{
+			{"recipient", "b"}, {"amount", "1uiris"},
+			{"recipient", "c"}, {"amount", "2uiris,3utest"},
+		}},
+		{Type: "unbond", Attributes: []Attribute{
+			{"amount", "100"}, {"height", "7"}, {"jailed", "true"}, {"completion_time", "2021-06-01T00:00:00Z"},
+		}},
+		{Type: "unknown", Attributes: []Attribute{{"key", "value"}}},
+	}
+	typed, err := registry.ParseTxResult(TxResult{Events: events})
+	require.NoError(t, err)
+	require.Equal(t, []TypedEvent{
+		&MessageEvent{Action: "send", Sender: "a"},
+		&testTransferEvent{Recipient: "b", Amount: NewCoins(NewInt64Coin("uiris", 1))},
+		&testTransferEvent{Recipient: "c", Amount: NewCoins(NewInt64Coin("uiris", 2), NewInt64Coin("utest", 3))},
+		&testUnbondEvent{
+			Amount:         NewInt(100),
+			Height:         7,
+			Jailed:         true,
+			CompletionTime: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		RawEvent{Type: "unknown", Attributes: []Attribute{{"key", "value"}}},
+	}, typed)
+
+	_, err = registry.ParseEndBlock(ResultEndBlock{Events: StringEvents{
+		{Type: "unbond", Attributes: []Attribute{{"height", "x"}}},
+	}})
+	require.Error(t, err)
+}
+
+func TestDecodeEvent(t *testing.T) {
+	events := StringEvents{
+		{Type: "transfer", Attributes: []Attribute{
+			{"recipient", "b"}, {"amount", "1uiris"},
+			{"recipient", "c"}, {"amount", "2uiris"},
+		}},
+	}
+
+	var transfer testTransferEvent
+	require.NoError(t, DecodeEvent(events, &transfer))
+	require.Equal(t, "b", transfer.Recipient)
+	require.Error(t, DecodeEvent(events, &testUnbondEvent{}))
+	require.Error(t, DecodeEvent(events, testTransferEvent{}))
+
+	var transfers []testTransferEvent
+	require.NoError(t, DecodeEvents(events, &transfers))
+	require.Len(t, transfers, 2)
+	require.Equal(t, "c", transfers[1].Recipient)
+	require.Equal(t, "2uiris", transfers[1].Amount.String())
+
+	var unbonds []testUnbondEvent
+	require.NoError(t, DecodeEvents(events, &unbonds))
+	require.Empty(t, unbonds)
+	require.Error(t, DecodeEvents(events, &[]string{}))
+
+	// a required attribute must be present, the others are left zero
+	var proposal testProposalEvent
+	require.NoError(t, DecodeEvent(StringEvents{
+		{Type: "submit_proposal", Attributes: []Attribute{{"proposal_id", "3"}}},
+	}, &proposal))
+	require.Equal(t, testProposalEvent{ProposalID: 3}, proposal)
+	require.Error(t, DecodeEvent(StringEvents{
+		{Type: "submit_proposal", Attributes: []Attribute{{"proposal_type", "Text"}}},
+	}, &testProposalEvent{}))
+	require.Error(t, DecodeEvent(StringEvents{
+		{Type: "submit_proposal", Attributes: []Attribute{{"proposal_id", ""}}},
+	}, &testProposalEvent{}))
+}